@@ -0,0 +1,365 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package lines
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// An EnvANSIWriter writes like an [EnvWriter] respectively an
+// [EnvLineWriter] to a component's lines whereas ANSI SGR escape
+// sequences setting colors and style attributes are translated into
+// style ranges of the written lines.  All other escape sequences and
+// control characters except new-lines and tabs are removed.  An
+// EnvANSIWriter keeps the style set by an escape sequence as well as an
+// incomplete escape sequence at the end of a write for its next write,
+// i.e. it may be used to copy a stream like a program's output to a
+// component:
+//
+//	io.Copy(e.ANSI(), cmdOutput)
+//
+// Note the component should be in Appending mode for that purpose
+// since otherwise each write replaces the component's content.  In
+// Appending mode a write continues the last line written by a previous
+// write as long as it is still the component's last line, i.e. a line
+// is not broken apart by being written in several writes.
+type EnvANSIWriter struct {
+	sty  *Style
+	line int
+	cmp  Componenter
+	ansi *ansi
+
+	// last is the last line written in Appending mode which is
+	// continued by the next write if it is still the component's last
+	// line.
+	last *Line
+
+	// inner set to true circumvents a panic if Componenter cmp is not
+	// enabled.
+	inner bool
+}
+
+func newEnvANSIWriter(
+	cmp Componenter, line int, sty *Style, inner bool,
+) *EnvANSIWriter {
+	base := cmp.globals().Style(Default)
+	if sty != nil {
+		base = *sty
+	}
+	return &EnvANSIWriter{line: line, cmp: cmp, sty: sty, inner: inner,
+		ansi: &ansi{base: base, sty: base}}
+}
+
+// Write given bytes bb to the lines of given ANSI writer w's component
+// whereby contained SGR escape sequences are translated into style
+// ranges of the written lines, see [EnvANSIWriter].
+func (w *EnvANSIWriter) Write(bb []byte) (int, error) {
+	c := w.cmp.embedded().component
+	if w.inner {
+		c = w.cmp.embedded().layoutComponent().wrapped()
+	}
+	txt, ss := w.ansi.parse(bb)
+	appending := c.mod&(Appending|Tailing) != 0
+	first, offset := len(*c.ll), 0
+	if appending && w.isLast(c) {
+		first, offset = first-1, len(w.last.rr)
+		head, tail, broken := bytes.Cut(txt, []byte("\n"))
+		w.last.setRunesAt(offset, []rune(string(head)))
+		txt = nil
+		if broken {
+			txt = tail
+		}
+	}
+	if txt != nil {
+		if _, err := c.write(txt, w.line, -1, w.sty); err != nil {
+			return 0, err
+		}
+	}
+	switch {
+	case !appending && w.line == -1:
+		first = 0
+	case !appending:
+		first = w.line
+	default:
+		w.last = (*c.ll)[len(*c.ll)-1]
+	}
+	for i, lss := range ss {
+		if len(lss) == 0 || first+i >= len(*c.ll) {
+			continue
+		}
+		l := (*c.ll)[first+i]
+		if l.ss == nil {
+			l.ss = styleRanges{}
+		}
+		for _, sr := range lss {
+			if i == 0 {
+				sr.Range = Range{sr.Range[0] + offset, sr.Range[1] + offset}
+			}
+			l.ss[sr.Range] = sr.Style
+		}
+		l.setDirty()
+	}
+	return len(bb), nil
+}
+
+// isLast returns true if the line given ANSI writer w has written last
+// is the last line of given component c.
+func (w *EnvANSIWriter) isLast(c *component) bool {
+	return w.last != nil && len(*c.ll) > 0 && (*c.ll)[len(*c.ll)-1] == w.last
+}
+
+// maxPendingEscape is the maximal length of an incomplete escape
+// sequence which is kept for the next write.  A longer incomplete
+// sequence is discarded.
+const maxPendingEscape = 1 << 12
+
+// ansi translates the ANSI escape sequences of written bytes into style
+// ranges.  base is the style a SGR reset sets while sty is the style
+// set by the last evaluated SGR sequence.  pending holds an incomplete
+// escape sequence at the end of the last parsed bytes.
+type ansi struct {
+	base, sty Style
+	pending   []byte
+}
+
+// parse returns given bytes bb with escape sequences and control
+// characters other than new-lines and tabs removed together with the
+// style ranges of each line of the returned text whose style differs
+// from given ansi a's base style.  The ranges are rune indices.
+func (a *ansi) parse(bb []byte) ([]byte, [][]SR) {
+	if len(a.pending) > 0 {
+		bb = append(a.pending, bb...)
+		a.pending = nil
+	}
+	txt, ss := make([]byte, 0, len(bb)), [][]SR{nil}
+	idx, start := 0, 0
+	flush := func() {
+		if idx > start && a.sty != a.base {
+			ss[len(ss)-1] = append(ss[len(ss)-1],
+				SR{Range: Range{start, idx}, Style: a.sty})
+		}
+		start = idx
+	}
+	for i := 0; i < len(bb); {
+		r, n := utf8.DecodeRune(bb[i:])
+		switch {
+		case r == '\x1b':
+			end, ok := escapeEnd(bb[i:])
+			if !ok {
+				if len(bb)-i <= maxPendingEscape {
+					a.pending = append([]byte{}, bb[i:]...)
+				}
+				n = len(bb) - i
+				break
+			}
+			if sgr, ok := sgrParams(bb[i : i+end]); ok {
+				flush()
+				a.sgr(sgr)
+			}
+			n = end
+		case r == '\n':
+			flush()
+			txt = append(txt, '\n')
+			ss = append(ss, nil)
+			idx, start = 0, 0
+		case r == '\t' || r >= ' ' && r != 0x7f && (r < 0x80 || r >= 0xa0):
+			txt = append(txt, bb[i:i+n]...)
+			idx++
+		}
+		i += n
+	}
+	flush()
+	return txt, ss
+}
+
+// escapeEnd returns the length of the escape sequence at the start of
+// given bytes bb and true or false if the sequence is incomplete.  A
+// control sequence (CSI) ends with its final byte, an operating system
+// command (OSC) with a bell or string terminator like other string
+// sequences and any other escape sequence with its final byte after
+// optional intermediate bytes.  An invalid byte inside a sequence ends
+// it before that byte.
+func escapeEnd(bb []byte) (int, bool) {
+	if len(bb) < 2 {
+		return 0, false
+	}
+	switch bb[1] {
+	case '[':
+		for i := 2; i < len(bb); i++ {
+			switch {
+			case bb[i] >= 0x40 && bb[i] <= 0x7e:
+				return i + 1, true
+			case bb[i] < 0x20 || bb[i] > 0x3f:
+				return i, true
+			}
+		}
+		return 0, false
+	case ']', 'P', 'X', '^', '_':
+		for i := 2; i < len(bb); i++ {
+			if bb[i] == '\a' && bb[1] == ']' {
+				return i + 1, true
+			}
+			if bb[i] == '\x1b' && i+1 < len(bb) && bb[i+1] == '\\' {
+				return i + 2, true
+			}
+		}
+		return 0, false
+	}
+	for i := 1; i < len(bb); i++ {
+		switch {
+		case bb[i] >= 0x30 && bb[i] <= 0x7e:
+			return i + 1, true
+		case bb[i] < 0x20 || bb[i] > 0x2f:
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// sgrParams returns the parameters of given escape sequence esc and
+// true iff it is a select graphic rendition (SGR) sequence.
+func sgrParams(esc []byte) (string, bool) {
+	if len(esc) < 3 || esc[1] != '[' || esc[len(esc)-1] != 'm' {
+		return "", false
+	}
+	pp := esc[2 : len(esc)-1]
+	for _, b := range pp {
+		if (b < '0' || b > '9') && b != ';' && b != ':' {
+			return "", false
+		}
+	}
+	return string(pp), true
+}
+
+// sgrAttributes maps the SGR parameters setting and resetting style
+// attributes to these attributes.
+var sgrAttributes = map[int]StyleAttributeMask{
+	1: Bold, 2: Dim, 3: Italic, 4: Underline, 5: Blink, 6: Blink,
+	7: Reverse, 9: StrikeThrough,
+	22: Bold | Dim, 23: Italic, 24: Underline, 25: Blink, 27: Reverse,
+	29: StrikeThrough,
+}
+
+// sgr updates given ansi a's style according to given SGR parameters
+// pp.  Sub-parameters separated by colons are supported for extended
+// colors, e.g. "38:2::255:0:0", otherwise they are ignored.
+func (a *ansi) sgr(pp string) {
+	params := strings.Split(pp, ";")
+	for i := 0; i < len(params); i++ {
+		sub := strings.Split(params[i], ":")
+		switch n := sgrInt(sub[0]); {
+		case n == 0:
+			a.sty = a.base
+		case n >= 1 && n <= 9 && sgrAttributes[n] != 0:
+			a.sty = a.sty.WithAdded(sgrAttributes[n])
+		case n >= 22 && n <= 29 && sgrAttributes[n] != 0:
+			a.sty = a.sty.WithRemoved(sgrAttributes[n])
+		case n >= 30 && n <= 37:
+			a.sty = a.sty.WithFG(ansiColor(n - 30))
+		case n >= 90 && n <= 97:
+			a.sty = a.sty.WithFG(ansiColor(n - 90 + 8))
+		case n >= 40 && n <= 47:
+			a.sty = a.sty.WithBG(ansiColor(n - 40))
+		case n >= 100 && n <= 107:
+			a.sty = a.sty.WithBG(ansiColor(n - 100 + 8))
+		case n == 39:
+			a.sty = a.sty.WithFG(a.base.FG())
+		case n == 49:
+			a.sty = a.sty.WithBG(a.base.BG())
+		case n == 38 || n == 48:
+			var c Color
+			var ok bool
+			if len(sub) > 1 {
+				c, _, ok = extendedColor(sub[1:], true)
+			} else {
+				var m int
+				c, m, ok = extendedColor(params[i+1:], false)
+				i += m
+			}
+			switch {
+			case !ok:
+			case n == 38:
+				a.sty = a.sty.WithFG(c)
+			default:
+				a.sty = a.sty.WithBG(c)
+			}
+		}
+	}
+}
+
+// sgrInt returns the integer of given SGR parameter p whereas an empty
+// or invalid parameter is zero.
+func sgrInt(p string) int {
+	n, err := strconv.Atoi(p)
+	if err != nil {
+		return 0
+	}
+	return n
+}
+
+// extendedColor returns the 256-palette or 24-bit color of given
+// parameters pp following a 38 or 48 SGR parameter together with the
+// number of consumed parameters.  False is returned if pp don't
+// specify a valid color.  Are pp colon separated sub-parameters an
+// rgb-color may be preceded by a color space id.
+func extendedColor(pp []string, sub bool) (Color, int, bool) {
+	if len(pp) == 0 {
+		return 0, 0, false
+	}
+	switch sgrInt(pp[0]) {
+	case 5:
+		if len(pp) < 2 {
+			return 0, len(pp), false
+		}
+		n := sgrInt(pp[1])
+		if n < 0 || n > 255 {
+			return 0, 2, false
+		}
+		return ansiColor(n), 2, true
+	case 2:
+		rgb := pp[1:]
+		if sub && len(pp) >= 5 {
+			rgb = pp[2:]
+		}
+		if len(rgb) < 3 {
+			return 0, len(pp), false
+		}
+		r, g, b := sgrInt(rgb[0]), sgrInt(rgb[1]), sgrInt(rgb[2])
+		if r > 255 || g > 255 || b > 255 {
+			return 0, 4, false
+		}
+		return Color(r<<16 | g<<8 | b), 4, true
+	}
+	return 0, 1, false
+}
+
+// ansiColors are the 16 standard and high intensity colors of the ANSI
+// color palette.
+var ansiColors = [16]Color{Black, Maroon, Green, Olive, Navy, Purple,
+	Teal, Silver, Grey, Red, Lime, Yellow, Blue, Fuchsia, Aqua, White}
+
+// ansiColor returns the color with given index n of the xterm 256 color
+// palette, i.e. the 16 ANSI colors followed by a 6x6x6 color cube and
+// 24 grey levels.
+func ansiColor(n int) Color {
+	switch {
+	case n < 16:
+		return ansiColors[n]
+	case n < 232:
+		n -= 16
+		level := func(v int) int {
+			if v == 0 {
+				return 0
+			}
+			return 55 + 40*v
+		}
+		return Color(level(n/36)<<16 | level(n/6%6)<<8 | level(n%6))
+	}
+	g := 8 + 10*(n-232)
+	return Color(g<<16 | g<<8 | g)
+}
@@ -0,0 +1,128 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package lines
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/slukits/gounit"
+)
+
+type ansiWriter struct{ Suite }
+
+func (s *ansiWriter) SetUp(t *T) { t.Parallel() }
+
+// ansiFX returns a fixture of given width and height whose component
+// is initialized by given callback.
+func (s *ansiWriter) ansiFX(
+	t *T, width, height int, init func(*cmpFX, *Env),
+) *Fixture {
+	fx := fx(t, &cmpFX{onInit: init})
+	fx.FireResize(width, height)
+	return fx
+}
+
+func (s *ansiWriter) Translates_ansi_colors(t *T) {
+	fx := s.ansiFX(t, 4, 1, func(_ *cmpFX, e *Env) {
+		fmt.Fprint(e.ANSI(), "a\x1b[31mb\x1b[0mc\x1b[92;44md")
+	})
+	t.Eq("abcd", fx.Screen())
+	cc := fx.Cells()[0]
+	t.Not.True(cc.HasFG(0, Maroon))
+	t.True(cc.HasFG(1, Maroon))
+	t.Not.True(cc.HasFG(2, Maroon))
+	t.True(cc.HasFG(3, Lime))
+	t.True(cc.HasBG(3, Navy))
+}
+
+func (s *ansiWriter) Translates_256_and_24_bit_colors(t *T) {
+	fx := s.ansiFX(t, 3, 1, func(_ *cmpFX, e *Env) {
+		fmt.Fprint(e.ANSI(),
+			"\x1b[38;5;196ma\x1b[48;2;1;2;3mb\x1b[38:2::4:5:6;49mc")
+	})
+	t.Eq("abc", fx.Screen())
+	cc := fx.Cells()[0]
+	t.True(cc.HasFG(0, Red))
+	t.True(cc.HasFG(1, Red))
+	t.True(cc.HasBG(1, Color(0x010203)))
+	t.True(cc.HasFG(2, Color(0x040506)))
+	t.Not.True(cc.HasBG(2, Color(0x010203)))
+}
+
+func (s *ansiWriter) Translates_style_attributes(t *T) {
+	fx := s.ansiFX(t, 3, 1, func(_ *cmpFX, e *Env) {
+		fmt.Fprint(e.ANSI(), "\x1b[1;3;4;7;9ma\x1b[22mb\x1b[mc")
+	})
+	cc := fx.Cells()[0]
+	t.True(cc.HasAA(0, Bold|Italic|Underline|Reverse|StrikeThrough))
+	t.Not.True(cc.HasAA(1, Bold))
+	t.True(cc.HasAA(1, Italic|Underline|Reverse|StrikeThrough))
+	t.Not.True(cc.HasAA(2, Italic))
+}
+
+func (s *ansiWriter) Strips_other_control_sequences(t *T) {
+	fx := s.ansiFX(t, 6, 1, func(_ *cmpFX, e *Env) {
+		fmt.Fprint(e.ANSI(),
+			"a\x1b[2Kb\x1b]0;title\x07c\rd\x1b(Be\x1b[?25l\x07f")
+	})
+	t.Eq("abcdef", fx.Screen())
+}
+
+func (s *ansiWriter) Keeps_its_style_across_lines_and_writes(t *T) {
+	fx := s.ansiFX(t, 2, 3, func(c *cmpFX, e *Env) {
+		c.LL.Mod(Appending)
+		w := e.ANSI()
+		fmt.Fprint(w, "\x1b[1ma\nb\x1b[")
+		fmt.Fprint(w, "0mc\nd")
+	})
+	t.Eq("a \nbc\nd ", fx.Screen())
+	cc := fx.Cells()
+	t.True(cc[0].HasAA(0, Bold))
+	t.True(cc[1].HasAA(0, Bold))
+	t.Not.True(cc[1].HasAA(1, Bold))
+	t.Not.True(cc[2].HasAA(0, Bold))
+}
+
+func (s *ansiWriter) Continues_a_line_written_in_several_writes(t *T) {
+	fx := s.ansiFX(t, 6, 3, func(c *cmpFX, e *Env) {
+		c.LL.Mod(Appending)
+		w := e.ANSI()
+		fmt.Fprint(w, "foo\x1b[3")
+		fmt.Fprint(w, "1mbar\n")
+		fmt.Fprint(w, "baz")
+	})
+	t.Eq("foobar\nbaz   \n      ", fx.Screen())
+	cc := fx.Cells()
+	t.Not.True(cc[0].HasFG(2, Maroon))
+	t.True(cc[0].HasFG(3, Maroon))
+	t.True(cc[0].HasFG(5, Maroon))
+	t.True(cc[1].HasFG(0, Maroon))
+}
+
+func (s *ansiWriter) Styles_on_top_of_a_line_writer_s_style(t *T) {
+	fx := s.ansiFX(t, 2, 2, func(_ *cmpFX, e *Env) {
+		fmt.Fprint(e.LL(1).BG(Navy).ANSI(), "\x1b[31ma\x1b[0mb")
+	})
+	t.Eq("  \nab", fx.Screen())
+	cc := fx.Cells()[1]
+	t.True(cc.HasFG(0, Maroon) && cc.HasBG(0, Navy))
+	t.True(cc.HasBG(1, Navy))
+	t.Not.True(cc.HasFG(1, Maroon))
+}
+
+func (s *ansiWriter) Maps_palette_indices_to_colors(t *T) {
+	t.Eq(Maroon, ansiColor(1))
+	t.Eq(Color(0x000000), ansiColor(16))
+	t.Eq(Color(0x0000ff), ansiColor(21))
+	t.Eq(Color(0xff0000), ansiColor(196))
+	t.Eq(Color(0x080808), ansiColor(232))
+	t.Eq(Color(0xeeeeee), ansiColor(255))
+}
+
+func TestANSIWriter(t *testing.T) {
+	t.Parallel()
+	Run(&ansiWriter{}, t)
+}
@@ -0,0 +1,80 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package lines
+
+import (
+	"sync"
+
+	"github.com/slukits/lines/internal/api"
+)
+
+// Backend is the contract a backend implementation must fulfill to be
+// used by a [Lines] instance, see [New].  A Backend displays runes with
+// their styles at given screen coordinates (see [Displayer]) and
+// reports user input events and posted events to the listener set by
+// the Lines instance (see [EventProcessor]).  Reported events are
+// expected to implement one of the interfaces [KeyEventer],
+// [RuneEventer], [MouseEventer], [ResizeEventer] or
+// [BracketPasteEventer]; events posted by Lines must be reported as
+// they were posted.  Each reported raw mouse event should be followed
+// by the aggregated event a [NewMouseAggregator] closure returns for
+// it, if any, since clicks, drags and drops are only reported to
+// components from aggregated events.  Optionally a Backend may implement [Suspender] to
+// support [Lines.Suspend] and [ClusterDisplayer] to display combining
+// characters.  The package backendtest provides a
+// conformance test suite for Backend implementations.
+type Backend = api.Backend
+
+// Displayer is the part of the [Backend] contract which provides the
+// screen as a set of lines and cells to which a rune at a given
+// position with a given style can be written.
+type Displayer = api.Displayer
+
+// EventProcessor is the part of the [Backend] contract which provides
+// user input events and programmatically posted events.
+type EventProcessor = api.EventProcessor
+
+// Suspender is optionally implemented by a [Backend] whose screen can
+// be temporarily handed back to other programs, see [Lines.Suspend].
+type Suspender = api.Suspender
+
+// ClusterDisplayer is optionally implemented by a [Backend] which
+// displays a grapheme cluster like a letter with a combining accent in
+// one screen cell; otherwise only a cluster's first rune is displayed.
+type ClusterDisplayer = api.ClusterDisplayer
+
+// ResizeEventer implementation is reported by a [Backend] on a
+// screen-size change.
+type ResizeEventer = api.ResizeEventer
+
+// BracketPasteEventer implementation is reported by a [Backend] at the
+// start and at the end of a bracketed paste, see [Paster].
+type BracketPasteEventer = api.BracketPasteEventer
+
+// NewMouseAggregator returns a closure for [Backend] implementations
+// which combines the raw mouse events it receives in reporting order to
+// the aggregated events [MouseMove], [MouseClick], [MouseDrag] and
+// [MouseDrop].  The closure returns nil if a received event doesn't
+// complete an aggregation.
+func NewMouseAggregator() func(MouseEventer) MouseEventer {
+	return api.NewMouseAggregator()
+}
+
+// TestCell is a cell of a [CellsLine] providing the cell's displayed
+// rune and style.
+type TestCell = api.TestCell
+
+// New returns a Lines instance with given backend displaying and
+// reporting events to given component cmp and its nested components.
+// New sets given Lines instance as listener of given backend.  The
+// quit bindings are the same as for an instance created by [Term].
+func New(backend Backend, cmp Componenter) *Lines {
+	ll := &Lines{}
+	ll.init(backend, cmp, false)
+	ll.Quitting = &quitting{Mutex: &sync.Mutex{}}
+	ll.Quitting.AddRune('q')
+	backend.Listen(ll.listen)
+	return ll
+}
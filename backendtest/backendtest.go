@@ -0,0 +1,366 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+/*
+Package backendtest provides a conformance test suite for [lines.Backend]
+implementations.  A backend implementation proves that it fulfills the
+backend contract lines relies on by running the suite with a function
+creating a new [Fixture] for each test:
+
+	func TestMyBackend(t *testing.T) {
+	    backendtest.Run(t, func(t *testing.T) backendtest.Fixture {
+	        return newMyBackendFixture(t)
+	    })
+	}
+
+The suite checks the reporting of user input events, of the mouse
+clicks, drags and drops aggregated from them (see
+[lines.NewMouseAggregator]) and of posted events, the ordering of events posted while an event is reported, the
+quitting of the event loop, the display of styled runes and the cursor
+handling.
+*/
+package backendtest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/slukits/gounit"
+	"github.com/slukits/lines"
+)
+
+// Fixture provides a backend under test together with the means to
+// emulate user input and to inspect the displayed screen content which
+// are not part of the backend contract.
+type Fixture interface {
+
+	// Backend returns the backend under test.  The returned backend
+	// must not have a listener set.
+	Backend() lines.Backend
+
+	// PostKey emulates a user special-key input.
+	PostKey(lines.Key, lines.ModifierMask) error
+
+	// PostRune emulates a user rune input.
+	PostRune(rune, lines.ModifierMask) error
+
+	// PostMouse emulates a user mouse input at given coordinates.
+	PostMouse(x, y int, _ lines.ButtonMask, _ lines.ModifierMask) error
+
+	// PostResize emulates a change of the available display area.
+	PostResize(width, height int) error
+
+	// Cells returns the screen content as it was made visible by the
+	// latest call of the backend's Update or Redraw method.
+	Cells() lines.CellsScreen
+}
+
+// Timeout is the duration the suite waits for an expected event to be
+// reported.
+var Timeout = 2 * time.Second
+
+// Run runs the conformance test suite against backends provided by
+// given fixture constructor fx which is called once for each test.
+func Run(t *testing.T, fx func(*testing.T) Fixture) {
+	t.Helper()
+	gounit.Run(&conformance{fx: fx}, t)
+}
+
+type conformance struct {
+	gounit.Suite
+	fx func(*testing.T) Fixture
+}
+
+// evtFX is posted by the conformance tests.
+type evtFX struct {
+	id   int
+	when time.Time
+}
+
+func (e *evtFX) When() time.Time     { return e.when }
+func (e *evtFX) Source() interface{} { return e }
+
+// events reports all events of a backend to a buffered channel.
+type events chan lines.Eventer
+
+func listen(b lines.Backend) events {
+	ee := make(events, 64)
+	b.Listen(func(e lines.Eventer) { ee <- e })
+	return ee
+}
+
+// next returns the next reported event or fatales given test if no
+// event was reported within Timeout.
+func (ee events) next(t *gounit.T) lines.Eventer {
+	t.GoT().Helper()
+	select {
+	case e := <-ee:
+		return e
+	case <-time.After(Timeout):
+		t.Fatal("backendtest: timeout waiting for event")
+	}
+	return nil
+}
+
+// nextOf skips all reported events until an event is reported which is
+// accepted by given function accept.
+func (ee events) nextOf(
+	t *gounit.T, accept func(lines.Eventer) bool,
+) lines.Eventer {
+	t.GoT().Helper()
+	for {
+		if e := ee.next(t); accept(e) {
+			return e
+		}
+	}
+}
+
+func isEvtFX(e lines.Eventer) bool { _, ok := e.(*evtFX); return ok }
+
+// resized waits for the initial resize event of given events.
+func (ee events) resized(t *gounit.T) lines.ResizeEventer {
+	t.GoT().Helper()
+	return ee.nextOf(t, func(e lines.Eventer) bool {
+		_, ok := e.(lines.ResizeEventer)
+		return ok
+	}).(lines.ResizeEventer)
+}
+
+func (s *conformance) Reports_a_resize_event_after_listener_is_set(
+	t *gounit.T,
+) {
+	fx := s.fx(t.GoT())
+	ee := listen(fx.Backend())
+	width, height := ee.resized(t).Size()
+	bWidth, bHeight := fx.Backend().Size()
+	t.Eq(bWidth, width)
+	t.Eq(bHeight, height)
+}
+
+func (s *conformance) Reports_posted_events_in_posting_order(t *gounit.T) {
+	fx := s.fx(t.GoT())
+	ee := listen(fx.Backend())
+	ee.resized(t)
+	for i := 1; i <= 3; i++ {
+		t.FatalOn(fx.Backend().Post(&evtFX{id: i, when: time.Now()}))
+	}
+	for i := 1; i <= 3; i++ {
+		t.Eq(i, ee.nextOf(t, isEvtFX).(*evtFX).id)
+	}
+}
+
+func (s *conformance) Reports_events_posted_during_reporting_afterwards(
+	t *gounit.T,
+) {
+	fx := s.fx(t.GoT())
+	b, ee := fx.Backend(), make(events, 64)
+	reporting, nested := false, false
+	b.Listen(func(e lines.Eventer) {
+		if reporting {
+			nested = true
+		}
+		reporting = true
+		defer func() { reporting = false }()
+		if e, ok := e.(*evtFX); ok && e.id == 1 {
+			b.Post(&evtFX{id: 2, when: time.Now()})
+		}
+		ee <- e
+	})
+	ee.resized(t)
+	t.FatalOn(b.Post(&evtFX{id: 1, when: time.Now()}))
+	t.Eq(1, ee.nextOf(t, isEvtFX).(*evtFX).id)
+	t.Eq(2, ee.nextOf(t, isEvtFX).(*evtFX).id)
+	t.Not.True(nested)
+}
+
+func (s *conformance) Reports_key_events(t *gounit.T) {
+	fx := s.fx(t.GoT())
+	ee := listen(fx.Backend())
+	ee.resized(t)
+	t.FatalOn(fx.PostKey(lines.Enter, lines.Alt))
+	e := ee.nextOf(t, func(e lines.Eventer) bool {
+		_, ok := e.(lines.KeyEventer)
+		return ok
+	}).(lines.KeyEventer)
+	t.Eq(lines.Enter, e.Key())
+	t.Eq(lines.Alt, e.Mod())
+}
+
+func (s *conformance) Reports_rune_events(t *gounit.T) {
+	fx := s.fx(t.GoT())
+	ee := listen(fx.Backend())
+	ee.resized(t)
+	t.FatalOn(fx.PostRune('x', lines.ZeroModifier))
+	e := ee.nextOf(t, func(e lines.Eventer) bool {
+		_, ok := e.(lines.RuneEventer)
+		return ok
+	}).(lines.RuneEventer)
+	t.Eq('x', e.Rune())
+	t.Eq(lines.ZeroModifier, e.Mod())
+}
+
+func (s *conformance) Reports_mouse_events(t *gounit.T) {
+	fx := s.fx(t.GoT())
+	ee := listen(fx.Backend())
+	ee.resized(t)
+	t.FatalOn(fx.PostMouse(4, 2, lines.Button1, lines.ZeroModifier))
+	e := ee.nextOf(t, func(e lines.Eventer) bool {
+		_, ok := e.(lines.MouseEventer)
+		return ok
+	}).(lines.MouseEventer)
+	x, y := e.Pos()
+	t.True(x == 4 && y == 2)
+	t.Eq(lines.Button1, e.Button())
+}
+
+func (s *conformance) Reports_a_click_after_button_press_and_release(
+	t *gounit.T,
+) {
+	fx := s.fx(t.GoT())
+	ee := listen(fx.Backend())
+	ee.resized(t)
+	t.FatalOn(fx.PostMouse(4, 2, lines.Button1, lines.ZeroModifier))
+	t.FatalOn(fx.PostMouse(4, 2, lines.ZeroButton, lines.ZeroModifier))
+	e := ee.nextOf(t, func(e lines.Eventer) bool {
+		_, ok := e.(*lines.MouseClick)
+		return ok
+	}).(*lines.MouseClick)
+	x, y := e.Pos()
+	t.True(x == 4 && y == 2)
+	t.Eq(lines.Button1, e.Button())
+}
+
+func (s *conformance) Reports_drags_and_a_drop_after_moving_a_pressed_button(
+	t *gounit.T,
+) {
+	fx := s.fx(t.GoT())
+	ee := listen(fx.Backend())
+	ee.resized(t)
+	t.FatalOn(fx.PostMouse(4, 2, lines.Button1, lines.ZeroModifier))
+	t.FatalOn(fx.PostMouse(5, 2, lines.Button1, lines.ZeroModifier))
+	t.FatalOn(fx.PostMouse(6, 3, lines.Button1, lines.ZeroModifier))
+	t.FatalOn(fx.PostMouse(6, 3, lines.ZeroButton, lines.ZeroModifier))
+	isDrag := func(e lines.Eventer) bool {
+		_, ok := e.(*lines.MouseDrag)
+		return ok
+	}
+	drag := ee.nextOf(t, isDrag).(*lines.MouseDrag)
+	x, y := drag.Pos()
+	t.True(x == 5 && y == 2)
+	ox, oy := drag.Origin()
+	t.True(ox == 4 && oy == 2)
+	drag = ee.nextOf(t, isDrag).(*lines.MouseDrag)
+	x, y = drag.Pos()
+	t.True(x == 6 && y == 3)
+	drop := ee.nextOf(t, func(e lines.Eventer) bool {
+		_, ok := e.(*lines.MouseDrop)
+		return ok
+	}).(*lines.MouseDrop)
+	x, y = drop.Pos()
+	t.True(x == 6 && y == 3)
+	t.Eq(lines.Button1, drop.Button())
+}
+
+func (s *conformance) Reports_resize_events_and_adapts_size(t *gounit.T) {
+	fx := s.fx(t.GoT())
+	ee := listen(fx.Backend())
+	ee.resized(t)
+	t.FatalOn(fx.PostResize(42, 22))
+	width, height := ee.resized(t).Size()
+	t.True(width == 42 && height == 22)
+	width, height = fx.Backend().Size()
+	t.True(width == 42 && height == 22)
+}
+
+func (s *conformance) Calls_quit_listeners_once_and_stops_reporting(
+	t *gounit.T,
+) {
+	fx := s.fx(t.GoT())
+	b := fx.Backend()
+	ee := listen(b)
+	ee.resized(t)
+	quitted := make(chan bool, 2)
+	b.OnQuit(func() { quitted <- true })
+	b.Quit()
+	b.Quit()
+	select {
+	case <-quitted:
+	case <-time.After(Timeout):
+		t.Fatal("backendtest: quit listener not called")
+	}
+	waited := make(chan bool)
+	go func() { b.WaitForQuit(); close(waited) }()
+	select {
+	case <-waited:
+	case <-time.After(Timeout):
+		t.Fatal("backendtest: wait for quit is blocking after quit")
+	}
+	t.FatalOn(b.Post(&evtFX{id: 1, when: time.Now()}))
+	select {
+	case <-quitted:
+		t.Error("backendtest: quit listener called twice")
+	case e := <-ee:
+		if isEvtFX(e) {
+			t.Error("backendtest: event reported after quit")
+		}
+	case <-time.After(Timeout / 10):
+	}
+}
+
+func (s *conformance) Displays_styled_runes_after_update(t *gounit.T) {
+	fx := s.fx(t.GoT())
+	b := fx.Backend()
+	ee := listen(b)
+	ee.resized(t)
+	sty := b.NewStyle().WithFG(lines.Red).WithBG(lines.Yellow).
+		WithAA(lines.Bold | lines.Underline)
+	b.Display(1, 2, 'x', sty)
+	b.Update()
+	cells := fx.Cells()
+	t.FatalIfNot(t.True(len(cells) > 2 && len(cells[2]) > 1))
+	t.Eq('x', cells[2][1].Rune)
+	t.Eq(lines.Red, cells[2][1].Style.FG())
+	t.Eq(lines.Yellow, cells[2][1].Style.BG())
+	t.Eq(lines.Bold|lines.Underline, cells[2][1].Style.AA())
+	t.Eq("x", cells.Trimmed().String())
+}
+
+func (s *conformance) Displays_grapheme_clusters_after_update(
+	t *gounit.T,
+) {
+	fx := s.fx(t.GoT())
+	b := fx.Backend()
+	cd, ok := b.(lines.ClusterDisplayer)
+	if !ok { // a ClusterDisplayer implementation is optional
+		return
+	}
+	ee := listen(b)
+	ee.resized(t)
+	cd.DisplayCluster(1, 2, []rune("e\u0301"), b.NewStyle())
+	b.Update()
+	cells := fx.Cells()
+	t.FatalIfNot(t.True(len(cells) > 2 && len(cells[2]) > 1))
+	t.Eq('e', cells[2][1].Rune)
+	t.Eq([]rune{'\u0301'}, cells[2][1].Combining)
+	t.Eq("e\u0301", cells.Trimmed().String())
+}
+
+func (s *conformance) Sets_cursor_inside_screen_only(t *gounit.T) {
+	fx := s.fx(t.GoT())
+	b := fx.Backend()
+	ee := listen(b)
+	ee.resized(t)
+	width, height := b.Size()
+
+	x, y, cs := b.SetCursor(1, 1)
+	t.True(x == 1 && y == 1 && cs == lines.ZeroCursor)
+	x, y, cs = b.SetCursor(2, 1, lines.BarCursorSteady)
+	t.True(x == 2 && y == 1 && cs == lines.BarCursorSteady)
+	x, y, cs = b.SetCursor(2, 1, lines.ZeroCursor)
+	t.True(x == -1 && y == -1 && cs == lines.ZeroCursor)
+	for _, xy := range [][2]int{{-1, 0}, {0, -1}, {width, 0}, {0, height}} {
+		x, y, cs = b.SetCursor(xy[0], xy[1], lines.BarCursorSteady)
+		t.True(x == -1 && y == -1 && cs == lines.ZeroCursor)
+	}
+}
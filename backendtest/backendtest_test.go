@@ -0,0 +1,68 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package backendtest
+
+import (
+	"testing"
+
+	"github.com/slukits/lines"
+	"github.com/slukits/lines/internal/term"
+)
+
+// termFX adapts the fixture of the terminal backend to the conformance
+// suite's Fixture.
+type termFX struct {
+	ui *term.UI
+	fx *term.Fixture
+}
+
+func (fx *termFX) Backend() lines.Backend { return fx.ui }
+
+func (fx *termFX) PostKey(k lines.Key, m lines.ModifierMask) error {
+	fx.fx.PostKey(k, m)
+	return nil
+}
+
+func (fx *termFX) PostRune(r rune, m lines.ModifierMask) error {
+	fx.fx.PostRune(r, m)
+	return nil
+}
+
+func (fx *termFX) PostMouse(
+	x, y int, b lines.ButtonMask, m lines.ModifierMask,
+) error {
+	fx.fx.PostMouse(x, y, b, m)
+	return nil
+}
+
+func (fx *termFX) PostResize(width, height int) error {
+	fx.fx.PostResize(width, height)
+	return nil
+}
+
+func (fx *termFX) Cells() lines.CellsScreen { return fx.fx.Cells() }
+
+func TestTermBackend(t *testing.T) {
+	t.Parallel()
+	Run(t, func(t *testing.T) Fixture {
+		ui, fx := term.NewFixture(t, 0)
+		return &termFX{ui: ui, fx: fx}
+	})
+}
+
+// headlessFX adapts the in-memory backend to the conformance suite's
+// Fixture.
+type headlessFX struct{ *term.UI }
+
+func (fx *headlessFX) Backend() lines.Backend { return fx.UI }
+
+func TestHeadlessBackend(t *testing.T) {
+	t.Parallel()
+	Run(t, func(t *testing.T) Fixture {
+		ui := term.NewHeadless(80, 25)
+		t.Cleanup(ui.Quit)
+		return &headlessFX{UI: ui}
+	})
+}
@@ -29,15 +29,18 @@ func (f *LineFocus) Eol() bool {
 	if !hasCursor {
 		return false
 	}
+	if f.c.isWrapping() {
+		slIdx, _, _ := f.c.wrapped().cursorPosition()
+		scIdx, _ = f.wrappedCell(slIdx, scIdx)
+	}
 	return f.isEol(f.Line(), scIdx)
 }
 
 func (f *LineFocus) isEol(line *Line, columnIdx int) bool {
-	availableContent := len(line.rr) - line.start
 	if !f.eolAfterLastRune {
-		return columnIdx+1 == availableContent
+		return columnIdx+line.start == line.lastCell()
 	}
-	return columnIdx == availableContent
+	return columnIdx+line.start == line.Len()
 }
 
 // NextCell moves the cursor to the next cell in the currently focused
@@ -49,6 +52,14 @@ func (s *LineFocus) NextCell() (slIdx, scIdx int, moved bool) {
 	if s.current < 0 || !haveCursorPos {
 		return -1, -1, false
 	}
+	if s.c.isWrapping() {
+		cell, rr := s.wrappedCell(slIdx, scIdx)
+		if s.isEol(s.Line(), cell) {
+			return slIdx, scIdx, false
+		}
+		slIdx, scIdx = s.setWrappedCell(s.Line().nextCell(cell), rr)
+		return slIdx, scIdx, true
+	}
 	if slIdx != s.Screen() {
 		panic("lines: line-focus: last cell: cursor-line is not " +
 			"focused line")
@@ -58,14 +69,15 @@ func (s *LineFocus) NextCell() (slIdx, scIdx int, moved bool) {
 		return slIdx, scIdx, false
 	}
 	_, _, screenWidth, _ := s.c.ContentArea()
-	if scIdx+1 < screenWidth {
-		s.c.setCursor(slIdx, scIdx+1)
-		return slIdx, scIdx + 1, true
+	by := line.nextCell(scIdx+line.start) - (scIdx + line.start)
+	if scIdx+by < screenWidth {
+		s.c.setCursor(slIdx, scIdx+by)
+		return slIdx, scIdx + by, true
 	}
 	if s.eolAfterLastRune {
 		screenWidth--
 	}
-	line.incrementStart(screenWidth)
+	line.incrementStart(screenWidth, by)
 	return slIdx, scIdx, false
 }
 
@@ -80,6 +92,9 @@ func (s *LineFocus) LastCell() (slIdx, scIdx int, moved bool) {
 	if s.current < 0 || scIdx < 0 {
 		return -1, -1, false
 	}
+	if s.c.isWrapping() {
+		return s.lastWrappedCell(slIdx, scIdx)
+	}
 	if slIdx != s.Screen() {
 		panic("lines: line-focus: last cell: cursor-line is not " +
 			"focused line")
@@ -92,20 +107,33 @@ func (s *LineFocus) LastCell() (slIdx, scIdx int, moved bool) {
 	if s.eolAfterLastRune {
 		screenWidth--
 	}
-	if line.Len() < screenWidth {
-		scIdx = line.Len()
-		if !s.eolAfterLastRune {
-			scIdx--
-		}
-		s.c.setCursor(slIdx, scIdx)
-		return slIdx, scIdx, true
-	}
-	scIdx = screenWidth
+	last := line.Len()
 	if !s.eolAfterLastRune {
-		scIdx--
+		last = line.lastCell()
+	}
+	if line.Len() < screenWidth {
+		s.c.setCursor(slIdx, last)
+		return slIdx, last, true
 	}
-	slIdx, scIdx, _ = s.c.SetCursor(slIdx, scIdx).CursorPosition()
 	line.moveStartToEnd(screenWidth)
+	slIdx, scIdx, _ = s.c.SetCursor(slIdx, last-line.start).
+		CursorPosition()
+	return slIdx, scIdx, true
+}
+
+// lastWrappedCell moves the cursor of the focused line of a wrapping
+// component from given cursor position to the line's last rune
+// respectively after it, see EolAfterLastRune.
+func (s *LineFocus) lastWrappedCell(slIdx, scIdx int) (int, int, bool) {
+	cell, rr := s.wrappedCell(slIdx, scIdx)
+	if s.isEol(s.Line(), cell) {
+		return slIdx, scIdx, false
+	}
+	last := s.Line().Len()
+	if !s.eolAfterLastRune && last > 0 {
+		last = s.Line().lastCell()
+	}
+	slIdx, scIdx = s.setWrappedCell(last, rr)
 	return slIdx, scIdx, true
 }
 
@@ -123,9 +151,9 @@ func (s *LineFocus) adjustLineEndCursor(
 
 	l := s.Line()
 	if l.Len() <= lastColumn {
-		return l.Len() - 1
+		return l.lastCell()
 	}
-	return lastColumn
+	return l.runeStart(lastColumn)
 }
 
 // FirstCell moves the cursor of the currently focused component line to
@@ -136,6 +164,12 @@ func (s *LineFocus) FirstCell() (slIdx, cl int, moved bool) {
 	if s.current < 0 || cl < 0 {
 		return -1, -1, false
 	}
+	if s.c.isWrapping() {
+		slIdx, _, _ = s.c.CursorPosition()
+		cell, rr := s.wrappedCell(slIdx, cl)
+		slIdx, cl = s.setWrappedCell(0, rr)
+		return slIdx, cl, cell != 0
+	}
 	if cl != 0 {
 		moved = true
 	}
@@ -168,9 +202,19 @@ func (s *LineFocus) PreviousCell() (slIdx, cl int, moved bool) {
 	if s.current < 0 || cl < 0 {
 		return -1, -1, false
 	}
-	if cl > 0 {
-		return s.c.SetCursor(s.Screen(), cl-1).CursorPosition()
+	if s.c.isWrapping() {
+		cell, rr := s.wrappedCell(slIdx, cl)
+		if cell == 0 {
+			return slIdx, cl, false
+		}
+		slIdx, cl = s.setWrappedCell(s.Line().previousCell(cell), rr)
+		return slIdx, cl, true
+	}
+	line := s.Line()
+	by := line.start + cl - line.previousCell(line.start+cl)
+	if cl > 0 && cl-by >= 0 {
+		return s.c.SetCursor(s.Screen(), cl-by).CursorPosition()
 	}
-	s.Line().decrementStart()
+	line.decrementStart(by)
 	return slIdx, cl, false
 }
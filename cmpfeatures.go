@@ -72,7 +72,9 @@ const (
 	Focusable FeatureMask = 1 << iota
 
 	// FocusMoveable enables to move focus between FocusMovable
-	// components using the default Tab-key.
+	// components in reading order using the default Tab-key
+	// respectively backwards using Shift-Tab.  Is a modal layer shown
+	// the focus moves only between its components.
 	FocusMovable
 
 	// UpScrollable makes a component's content up-scrollable by the
@@ -133,6 +135,20 @@ const (
 	// editable makes a component's content editable by the user.
 	editable
 
+	// splitDraggable makes the splits between the nested components of
+	// a stacking or chaining component draggable by the mouse.
+	splitDraggable
+
+	// SplitBackwardMovable moves the split after the nested component
+	// of a stacking or chaining component which contains the focus up
+	// respectively to the left (default Alt+Up, Alt+Left).
+	SplitBackwardMovable
+
+	// SplitForwardMovable moves the split after the nested component
+	// of a stacking or chaining component which contains the focus down
+	// respectively to the right (default Alt+Down, Alt+Right).
+	SplitForwardMovable
+
 	// NoFeature classifies keys/runes/buttons not registered for any
 	// feature.
 	NoFeature FeatureMask = 0
@@ -156,6 +172,17 @@ const (
 		LinesFocusable | LastCellFocusable | FirstCellFocusable
 
 	Editable = Focusable | CellFocusable | Scrollable | editable
+
+	// Splittable makes the splits between the nested components of a
+	// stacking or chaining component movable by dragging a split's
+	// handle, i.e. the last screen line respectively column of the
+	// split's first component, or by the keys of SplitBackwardMovable
+	// and SplitForwardMovable.  A drag starting at a handle is reported
+	// to the components at the handle instead if one of them implements
+	// [Drager].  Is the last nested component focused the keys move the
+	// split before it.  See [Component.Splits].
+	Splittable = splitDraggable | SplitBackwardMovable |
+		SplitForwardMovable
 )
 
 // Features provides access and fine grained control over the behavior
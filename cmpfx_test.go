@@ -30,6 +30,9 @@ const (
 	onEdit
 	onOutOfBoundClick
 	onOutOfBoundMove
+	onDismiss
+	onHide
+	onShow
 	onMouseN
 	onKeyN
 )
@@ -238,6 +241,31 @@ type chainingFX struct {
 	Chaining
 }
 
+type responsiveFX struct {
+	cmpFX
+	Responsive
+}
+
+type deckingFX struct {
+	cmpFX
+	Decking
+}
+
+type gridingFX struct {
+	cmpFX
+	Griding
+}
+
+type placingFX struct {
+	cmpFX
+	Placing
+}
+
+type viewportingFX struct {
+	cmpFX
+	Viewporting
+}
+
 type modalLayerFX struct {
 	cmpFX
 	onOutOfBoundClick func(*modalLayerFX, *Env)
@@ -260,6 +288,25 @@ func (l *modalLayerFX) OnOutOfBoundMove(e *Env) bool {
 	return false
 }
 
+type modalStackingFX struct {
+	modalLayerFX
+	Stacking
+}
+
+type dismisserFX struct {
+	modalLayerFX
+}
+
+func (l *dismisserFX) OnDismiss(e *Env) { l.increment(onDismiss) }
+
+type hiderFX struct {
+	cmpFX
+}
+
+func (c *hiderFX) OnHide(e *Env) { c.increment(onHide) }
+
+func (c *hiderFX) OnShow(e *Env) { c.increment(onShow) }
+
 type framingFX struct {
 	cmpFX
 	filler rune
@@ -17,12 +17,75 @@ type LayerPos = lyt.LayerPos
 // [Component.Layered].
 var NewLayerPos = lyt.NewLayerPos
 
+// Placement defines at which side of its anchor an anchored layer is
+// placed, see [NewAnchoredLayerPos].
+type Placement = lyt.Placement
+
+const (
+	PlaceBelow = lyt.PlaceBelow
+	PlaceAbove = lyt.PlaceAbove
+	PlaceRight = lyt.PlaceRight
+	PlaceLeft  = lyt.PlaceLeft
+)
+
+// Alignment defines how an anchored layer is aligned along the side of
+// its anchor it is placed at, see [NewAnchoredLayerPos].
+type Alignment = lyt.Alignment
+
+const (
+	AlignStart  = lyt.AlignStart
+	AlignCenter = lyt.AlignCenter
+	AlignEnd    = lyt.AlignEnd
+)
+
+// NewAnchoredLayerPos creates a layer positioning of given width and
+// height which places a layer at given placement p next to given
+// anchor component aligned according to given alignment a.  Is there
+// not enough room at given side of the anchor on the screen the layer
+// is flipped to the opposite side; is there neither the layer is
+// shifted to stay on the screen.  An anchored layer follows its
+// anchor, e.g. after a resize.  The anchor's position is evaluated
+// whenever the layer is laid out, i.e. the anchor doesn't need to be
+// initialized at the time the positioning is created.  A layer whose
+// anchor is not laid out is placed like a layer of an off-screen
+// anchor.  A none positive width or height defaults to 30 respectively
+// 8.
+func NewAnchoredLayerPos(
+	anchor Componenter, p Placement, a Alignment, width, height int,
+) *LayerPos {
+	return lyt.NewAnchoredLayerPos(
+		layerAnchor{cmp: anchor}, p, a, width, height)
+}
+
+// layerAnchor provides the layout dimensions of an anchor component of
+// a layer at the time the layer is laid out.
+type layerAnchor struct{ cmp Componenter }
+
+// Dim returns the layout dimensions of given layer anchor a's component
+// or nil if the component is not initialized yet.
+func (a layerAnchor) Dim() *lyt.Dim {
+	if !a.cmp.hasLayoutWrapper() {
+		return nil
+	}
+	return a.cmp.layoutComponent().Dim()
+}
+
+// NewCellAnchoredLayerPos creates a layer positioning like
+// [NewAnchoredLayerPos] whose anchor is the screen cell at given
+// coordinates x and y, e.g. the position of a mouse click.
+var NewCellAnchoredLayerPos = lyt.NewCellAnchoredLayerPos
+
 // Modaler must be implemented by a layer-component which wants to be
 // dealt with by the user before the user does anything else.  A layer
-// component is a component provided to [Component.Layered].  The "last"
-// modal layer gets the focus and stays focused until the layer is
-// removed.  Mouse clicks at a position outside a modal layer are
-// reported to OnOutsideMouse.
+// component is a component provided to [Component.Layered].  The
+// top-most modal layer gets the focus which is trapped inside it until
+// the layer is removed, i.e. the focus may only move between the
+// layer's components, see [FocusMovable].  Then the focus is restored
+// to the component which was focused before.  Modal layers may be
+// layered by modal layers.  Mouse clicks at a position outside a modal
+// layer are reported to OnOutOfBoundClick while an Esc-key which was
+// neither stopped from bubbling nor bound to a feature removes the
+// top-most modal layer, see [Dismisser].
 type Modaler interface {
 
 	// OnOutOfBoundClick gets mouse clicks reported whose position is
@@ -34,6 +97,17 @@ type Modaler interface {
 	OnOutOfBoundClick(e *Env) (continueReporting bool)
 }
 
+// Dismisser is implemented by a modal layer which wants to be informed
+// that it is removed because the user pressed Esc.  Stop the bubbling
+// of the Esc-key in an OnKey implementation of the layer to prevent its
+// dismissal.
+type Dismisser interface {
+
+	// OnDismiss is reported to the top-most modal layer before it is
+	// removed due to an Esc-key.
+	OnDismiss(*Env)
+}
+
 // OutOfBoundMover is implemented by a modal layers which want to be
 // informed about mouse movement outside their layed out boundaries.
 type OutOfBoundMover interface {
@@ -77,23 +151,50 @@ func (c *Component) Layered(e *Env, l Componenter, pos *LayerPos) {
 	if c.layoutCmp == e.Lines.scr.lyt.Root {
 		e.Lines.scr.lyt.Root = lc
 	}
+	if c.layoutCmp == e.Lines.scr.focus {
+		e.Lines.scr.focus = lc
+	}
 	c.layoutCmp = lc
 }
 
 // RemoveLayer removes given component c's association with a layering
-// component which is removed from the layout.
+// component which is removed from the layout.  Was the removed layer
+// modal the focus is restored to the component which was focused before
+// the modal layer got the focus.
 func (c *Component) RemoveLayer(e *Env) {
 	// provoke a panic in case c is disabled
 	lc, ok := c.userCmp.layoutComponent().(*layeredComponent)
 	if !ok || lc == nil {
 		return
 	}
-	_, ok = lc.layer.Root.(layoutComponenter).userComponent().(Modaler)
-	if ok {
-		e.Lines.Focus(lc.userComponent())
-	}
 	if c.layoutCmp == e.Lines.scr.lyt.Root {
 		e.Lines.scr.lyt.Root = lc.component
 	}
+	if c.layoutCmp == e.Lines.scr.focus {
+		e.Lines.scr.focus = lc.component
+	}
 	c.layoutCmp = lc.component
 }
+
+// RaiseLayer puts the layer associated with given component c by
+// [Component.Layered] on top of all other layers, i.e. it is displayed
+// above them and receives mouse events at positions they overlap.
+func (c *Component) RaiseLayer(e *Env) {
+	// provoke a panic in case c is disabled
+	lc, ok := c.userCmp.layoutComponent().(*layeredComponent)
+	if !ok || lc == nil {
+		return
+	}
+	e.Lines.scr.lyt.Layers.Raise(lc.layer)
+}
+
+// LowerLayer puts the layer associated with given component c by
+// [Component.Layered] below all other layers.
+func (c *Component) LowerLayer(e *Env) {
+	// provoke a panic in case c is disabled
+	lc, ok := c.userCmp.layoutComponent().(*layeredComponent)
+	if !ok || lc == nil {
+		return
+	}
+	e.Lines.scr.lyt.Layers.Lower(lc.layer)
+}
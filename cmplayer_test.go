@@ -353,6 +353,138 @@ func (s *Layer) Is_moved_to_provided_coordinates(t *T) {
 	t.Eq("0st\n2nd\n3rd", fx.Screen())
 }
 
+func (s *Layer) Is_placed_next_to_its_anchor(t *T) {
+	cc := []*cmpFX{}
+	for _, l := range []string{"1st", "2nd", "3rd"} {
+		l := l
+		cc = append(cc, &cmpFX{onInit: func(_ *cmpFX, e *Env) {
+			fmt.Fprint(e, l)
+		}})
+	}
+	stk := &stackingFX{Stacking: Stacking{
+		CC: []Componenter{cc[0], cc[1], cc[2]}}}
+	fx := fx(t, stk)
+	fx.FireResize(3, 3)
+	lyr := &cmpFX{onInit: func(_ *cmpFX, e *Env) { fmt.Fprint(e, "0") }}
+	fx.Lines.Update(cc[1], nil, func(e *Env) {
+		cc[1].Layered(e, lyr, NewAnchoredLayerPos(
+			cc[0], PlaceBelow, AlignCenter, 1, 1))
+	})
+	t.Eq("1st\n20d\n3rd", fx.Screen())
+	lyr = &cmpFX{onInit: func(_ *cmpFX, e *Env) { fmt.Fprint(e, "0") }}
+	fx.Lines.Update(cc[1], nil, func(e *Env) {
+		cc[1].Layered(e, lyr, NewAnchoredLayerPos(
+			cc[2], PlaceBelow, AlignEnd, 1, 1))
+	})
+	t.Eq("1st\n2n0\n3rd", fx.Screen())
+}
+
+func (s *Layer) Follows_an_anchor_initialized_after_its_positioning(
+	t *T,
+) {
+	cc := []*cmpFX{}
+	for _, l := range []string{"1st", "2nd", "3rd"} {
+		l := l
+		cc = append(cc, &cmpFX{onInit: func(_ *cmpFX, e *Env) {
+			fmt.Fprint(e, l)
+		}})
+	}
+	stk := &stackingFX{Stacking: Stacking{
+		CC: []Componenter{cc[0], cc[1]}}}
+	fx := fx(t, stk)
+	fx.FireResize(3, 3)
+	lyr := &cmpFX{onInit: func(_ *cmpFX, e *Env) { fmt.Fprint(e, "0") }}
+	fx.Lines.Update(cc[0], nil, func(e *Env) {
+		t.Not.True(cc[2].hasLayoutWrapper())
+		cc[0].Layered(e, lyr, NewAnchoredLayerPos(
+			cc[2], PlaceAbove, AlignEnd, 1, 1))
+		stk.CC = append(stk.CC, cc[2])
+	})
+	t.Eq("1st\n2n0\n3rd", fx.Screen())
+	fx.FireResize(5, 3)
+	t.Eq("1st  \n2nd 0\n3rd  ", fx.Screen())
+}
+
+func (s *Layer) Is_raised_above_and_lowered_below_other_layers(t *T) {
+	cc := []*cmpFX{{}, {}}
+	stk := &stackingFX{Stacking: Stacking{CC: []Componenter{cc[0], cc[1]}}}
+	fx := fx(t, stk)
+	fx.FireResize(3, 2)
+	ll := []*cmpFX{
+		{onInit: func(_ *cmpFX, e *Env) { fmt.Fprint(e, "1") }},
+		{onInit: func(_ *cmpFX, e *Env) { fmt.Fprint(e, "2") }},
+	}
+	for i, c := range cc {
+		c, l := c, ll[i]
+		fx.Lines.Update(c, nil, func(e *Env) {
+			c.Layered(e, l, NewLayerPos(1, 0, 1, 1))
+		})
+	}
+	t.Eq(" 2 \n   ", fx.Screen())
+	fx.Lines.Update(cc[0], nil, func(e *Env) { cc[0].RaiseLayer(e) })
+	t.Eq(" 1 \n   ", fx.Screen())
+	fx.FireClick(1, 0)
+	t.True(ll[0].N(onMouseN) > 0 && ll[1].N(onMouseN) == 0)
+	fx.Lines.Update(cc[0], nil, func(e *Env) { cc[0].LowerLayer(e) })
+	t.Eq(" 2 \n   ", fx.Screen())
+	fx.FireClick(1, 0)
+	t.True(ll[1].N(onMouseN) > 0)
+}
+
+func (s *Layer) Traps_focus_in_top_most_modal_layer(t *T) {
+	cmp, mdl := &cmpFX{}, &modalStackingFX{}
+	cmp.onInit = func(c *cmpFX, e *Env) { c.FF.Set(FocusMovable) }
+	mdl.onInit = func(c *cmpFX, e *Env) { c.FF.Set(FocusMovable) }
+	cc := []*cmpFX{{}, {}}
+	for _, c := range cc {
+		c.onInit = func(c *cmpFX, e *Env) { c.FF.Set(FocusMovable) }
+		mdl.CC = append(mdl.CC, c)
+	}
+	fx := fx(t, cmp)
+	fx.Lines.Update(cmp, nil, func(e *Env) {
+		cmp.Layered(e, mdl, nil)
+	})
+	t.Eq(mdl, fx.Lines.scr.focus.userComponent())
+	fx.FireKey(Tab)
+	t.Eq(cc[0], fx.Lines.scr.focus.userComponent())
+	fx.FireKey(Tab)
+	t.Eq(cc[1], fx.Lines.scr.focus.userComponent())
+	fx.FireKey(Tab)
+	t.Eq(mdl, fx.Lines.scr.focus.userComponent())
+	fx.FireKey(Backtab)
+	t.Eq(cc[1], fx.Lines.scr.focus.userComponent())
+	t.FatalOn(fx.Lines.Focus(cmp))
+	t.Eq(cc[1], fx.Lines.scr.focus.userComponent())
+}
+
+func (s *Layer) Dismisses_only_top_most_modal_on_esc(t *T) {
+	cmp, inner := &stackingFX{}, &cmpFX{}
+	cmp.CC = append(cmp.CC, inner)
+	mdl, top := &modalStackingFX{}, &dismisserFX{}
+	mdlInner := &cmpFX{}
+	mdl.CC = append(mdl.CC, mdlInner)
+	fx := fx(t, cmp)
+	t.FatalOn(fx.Lines.Focus(inner))
+	fx.Lines.Update(inner, nil, func(e *Env) {
+		inner.Layered(e, mdl, nil)
+	})
+	t.Eq(mdl, fx.Lines.scr.focus.userComponent())
+	t.FatalOn(fx.Lines.Focus(mdlInner))
+	fx.Lines.Update(mdlInner, nil, func(e *Env) {
+		mdlInner.Layered(e, top, NewLayerPos(1, 1, 5, 5))
+	})
+	t.Eq(top, fx.Lines.scr.focus.userComponent())
+
+	fx.FireKey(Esc)
+	t.Eq(1, top.N(onDismiss))
+	t.Eq(mdlInner, fx.Lines.scr.focus.userComponent())
+	fx.FireKey(Esc)
+	t.Eq(inner, fx.Lines.scr.focus.userComponent())
+	fx.Lines.Update(cmp, nil, func(e *Env) {
+		t.Eq(0, e.Lines.scr.lyt.Layers.Len())
+	})
+}
+
 func TestLayer(t *testing.T) {
 	t.Parallel()
 	Run(&Layer{}, t)
@@ -35,7 +35,12 @@ type ComponentLines struct {
 	Focus *LineFocus
 }
 
-// Mod sets how given component lines cll are maintained.
+// Mod sets how given component lines cll are maintained.  Overwriting,
+// Appending and Tailing are exclusive modes of how content is written
+// while WordWrapping, RuneWrapping and Truncating are exclusive modes of
+// how content lines are displayed, i.e. a component may be for example
+// tailing and word-wrapping.  Note the wrapping modes don't apply to
+// the content of a component source.
 func (cll *ComponentLines) Mod(cm ComponentMode) {
 	switch cm {
 	case Appending:
@@ -47,6 +52,14 @@ func (cll *ComponentLines) Mod(cm ComponentMode) {
 	case Tailing:
 		cll.c.mod &^= Appending | Overwriting
 		cll.c.mod |= Tailing
+	case WordWrapping, RuneWrapping, Truncating:
+		if cll.c.mod&(WordWrapping|RuneWrapping|Truncating) == cm {
+			return
+		}
+		cll.c.mod &^= WordWrapping | RuneWrapping | Truncating
+		cll.c.mod |= cm
+		cll.c._firstRow = 0
+		cll.c.dirty = true
 	}
 }
 
@@ -193,6 +206,10 @@ func (ll lines) For(offset int, cb func(int, *Line) (stop bool)) {
 // index whereas this may be an associated component's content line
 // index or the content index of associated component's source liner.
 func (s Scroller) CoordinateToContentIndex(y int) (line int) {
+	if s.c.isWrapping() {
+		line, _ = s.c.lineAt(s.c.row() + y)
+		return line
+	}
 	if s.c.First() == 0 {
 		return y
 	}
@@ -163,8 +163,11 @@ func (c *component) sync(rw runeWriter) {
 		return
 	}
 	cll := c.ContentScreenLines()
-	if c.mod&Tailing == Tailing && c.Len() >= cll {
-		c.setFirst(c.Len() - cll)
+	if c.mod&Tailing == Tailing && c.rowsLen() >= cll {
+		c.setRow(c.rowsLen() - cll)
+	}
+	if c.isWrapping() && c.ll.IsDirty() && !c.dirty {
+		c.dirty = true // a changed line may change the following rows
 	}
 	if c.Scroll.Bar { // && c.Scroll.bar != c._first
 		c.Scroll.setScrollBar()
@@ -174,12 +177,58 @@ func (c *component) sync(rw runeWriter) {
 	} else {
 		c.syncContent(rw)
 	}
+	if _, ok := c.userCmp.layoutComponent().(lyt.Placer); ok {
+		c.syncPlaced(rw)
+		return
+	}
+	if _, ok := c.userCmp.layoutComponent().(*viewportWrapper); ok {
+		rw = clipping(rw, c)
+	}
 	c.forNested(func(n *component) (stop bool) {
 		n.sync(rw)
 		return false
 	})
 }
 
+// clippingWriter passes only runes inside its area on to the wrapped
+// rune writer, e.g. to prevent a nested component which is partially
+// scrolled out of a viewport or placed partially outside of a placer
+// from being written outside of it.
+type clippingWriter struct {
+	runeWriter
+	x, y, width, height int
+}
+
+// clipping returns given rune writer rw wrapped in a clipping writer
+// for given component c's content area.
+func clipping(rw runeWriter, c *component) *clippingWriter {
+	x, y, width, height := c.ContentArea()
+	return &clippingWriter{runeWriter: rw, x: x, y: y, width: width,
+		height: height}
+}
+
+func (w *clippingWriter) clips(x, y int) bool {
+	return x < w.x || y < w.y || x >= w.x+w.width || y >= w.y+w.height
+}
+
+func (w *clippingWriter) Display(x, y int, r rune, s Style) {
+	if w.clips(x, y) {
+		return
+	}
+	w.runeWriter.Display(x, y, r, s)
+}
+
+func (w *clippingWriter) DisplayCluster(x, y int, c []rune, s Style) {
+	if w.clips(x, y) {
+		return
+	}
+	if cd, ok := w.runeWriter.(ClusterDisplayer); ok {
+		cd.DisplayCluster(x, y, c, s)
+		return
+	}
+	w.runeWriter.Display(x, y, c[0], s)
+}
+
 func (c *component) syncContent(rw runeWriter) {
 	if c.gaps.isDirty() {
 		gx, gy, gw, gh := c.Dim().Printable()
@@ -203,8 +252,8 @@ func (c *component) syncContent(rw runeWriter) {
 
 // clear fills the receiving component's printable area with spaces.
 func (c *component) syncCleared(rw runeWriter) {
-	if c.First()+c.ContentScreenLines() > c.Len() {
-		c.setFirst(ints.Max(0, c.Len()-c.ContentScreenLines()))
+	if c.row()+c.ContentScreenLines() > c.rowsLen() {
+		c.setRow(ints.Max(0, c.rowsLen()-c.ContentScreenLines()))
 	}
 	cx, cy, cw, ch := c.dim.Screen()
 	for y := cy; y < cy+ch; y++ {
@@ -229,6 +278,10 @@ func (c *component) syncCleared(rw runeWriter) {
 		}
 		c.Src.sync(ch, c)
 	}
+	if c.isWrapping() {
+		c.syncWrapped(cx, cy, cw, ch, rw)
+		return
+	}
 	c.ll.For(c._first, func(i int, l *Line) (stop bool) {
 		if i >= ch {
 			return true
@@ -246,6 +299,18 @@ func (c *component) forNested(cb func(n *component) (stop bool)) {
 	} else { // ignore chainer if stacker and chainer are provided
 		if c, ok := lc.(lyt.Chainer); ok {
 			nested = c.ForChained
+		} else if g, ok := lc.(lyt.Grider); ok {
+			nested = func(cb func(Dimer) (stop bool)) {
+				g.ForGridded(func(d Dimer, _ lyt.Cell) (stop bool) {
+					return cb(d)
+				})
+			}
+		} else if p, ok := lc.(lyt.Placer); ok {
+			nested = func(cb func(Dimer) (stop bool)) {
+				lyt.ForPlaced(p, func(d Dimer, _ lyt.Place) (stop bool) {
+					return cb(d)
+				})
+			}
 		}
 	}
 	if nested == nil {
@@ -313,11 +378,11 @@ func (c *component) setFirst(f int) {
 		return
 	}
 
-	if f < 0 || f == c._first || f >= c.Len() {
+	if f < 0 || f == c._first && c._firstRow == 0 || f >= c.Len() {
 		return
 	}
 
-	c._first = f
+	c._first, c._firstRow = f, 0
 	if c.dirty {
 		return
 	}
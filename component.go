@@ -97,6 +97,20 @@ const (
 	// Tailing is appending and displaying the contents "tail"
 	// especially if the display area cannot show all the content.
 	Tailing
+
+	// WordWrapping displays a content line which is wider than its
+	// component over several screen lines breaking it after the last
+	// space which fits into a screen line if possible.
+	WordWrapping
+
+	// RuneWrapping displays a content line which is wider than its
+	// component over several screen lines breaking it at the
+	// component's width.
+	RuneWrapping
+
+	// Truncating displays each content line in one screen line
+	// truncating it at the component's width which is the default.
+	Truncating
 )
 
 func (c *Component) initialize(
@@ -123,10 +137,21 @@ func (c *Component) initialize(
 	inner.LL = newComponentLines(c)
 	inner.gg.SetUpdateListener(cmpGlobalsClosure(inner))
 	switch userComponent.(type) {
+	case Responder:
+		inner.breakpoint, inner.layoutBreakpoint = -1, -1
+		c.layoutCmp = &responsiveWrapper{component: inner}
 	case Stacker:
 		c.layoutCmp = &stackingWrapper{component: inner}
 	case Chainer:
 		c.layoutCmp = &chainingWrapper{component: inner}
+	case Grider:
+		c.layoutCmp = &gridingWrapper{component: inner}
+	case Placer:
+		c.layoutCmp = &placingWrapper{component: inner}
+	case Decker:
+		c.layoutCmp = &deckingWrapper{component: inner}
+	case Viewporter:
+		c.layoutCmp = &viewportWrapper{component: inner}
 	default:
 		c.layoutCmp = inner
 	}
@@ -178,8 +203,8 @@ func (c *Component) layoutComponent() layoutComponenter {
 	return c.layoutCmp
 }
 
-// isNesting returns true if the component is stacking or chaining other
-// components.
+// isNesting returns true if the component is stacking, chaining,
+// griding, placing, decking or viewporting other components.
 func (c *Component) isNesting() bool {
 	if !c.isInitialized() {
 		return false
@@ -189,6 +214,16 @@ func (c *Component) isNesting() bool {
 		return true
 	case *chainingWrapper:
 		return true
+	case *responsiveWrapper:
+		return true
+	case *gridingWrapper:
+		return true
+	case *placingWrapper:
+		return true
+	case *deckingWrapper:
+		return true
+	case *viewportWrapper:
+		return true
 	}
 	return false
 }
@@ -266,8 +301,25 @@ type component struct {
 	// _first holds the content line index of the _first displayed line
 	_first int
 
+	// _firstRow holds the index of the row of the first displayed line
+	// which is displayed first by a wrapping component.
+	_firstRow int
+
 	// slctd hold the index of the currently selected line
 	slctd int
+
+	// splits are split positions set before a stacking or chaining
+	// component was layed out.
+	splits []int
+
+	// hide is true if a component was asked to be hidden while hidden
+	// is true if it is actually removed from the layout.
+	hide, hidden bool
+
+	// breakpoint is the index of the active breakpoint of a responsive
+	// component while layoutBreakpoint is the index of the breakpoint
+	// which is applied to the layout.
+	breakpoint, layoutBreakpoint int
 }
 
 // component gets the component out of a layoutComponenter without using
@@ -310,6 +362,12 @@ func (c *component) setCursor(
 	if _, ok := c.userCmp.layoutComponent().(lyt.Chainer); ok {
 		return
 	}
+	if _, ok := c.userCmp.layoutComponent().(lyt.Grider); ok {
+		return
+	}
+	if _, ok := c.userCmp.layoutComponent().(lyt.Placer); ok {
+		return
+	}
 	if line < 0 || column < 0 {
 		c.gg.setCursor(line, column)
 		if !c.cursorMoved {
@@ -365,37 +423,29 @@ type layoutComponenter interface {
 	userComponent() Componenter
 }
 
-// stackingWrapper wraps a stacking user-component for the layout
-// manager.  Avoiding panics on Gaps- or Dim-access through the layout
-// manager
-type stackingWrapper struct{ *component }
-
-func (sw *stackingWrapper) Gaps() api.Gaps {
-	if sw.gaps == nil {
+// gapsOf returns the sizes of given component c's gaps for the layout
+// manager, i.e. zero sizes if c has no gaps.
+func gapsOf(c *component) api.Gaps {
+	if c.gaps == nil {
 		return api.Gaps{}
 	}
 	return api.Gaps{
-		Top:    len(sw.gaps.top.ll),
-		Right:  len(sw.gaps.right.ll),
-		Bottom: len(sw.gaps.bottom.ll),
-		Left:   len(sw.gaps.left.ll),
+		Top:    len(c.gaps.top.ll),
+		Right:  len(c.gaps.right.ll),
+		Bottom: len(c.gaps.bottom.ll),
+		Left:   len(c.gaps.left.ll),
 	}
 }
 
+// stackingWrapper wraps a stacking user-component for the layout
+// manager.  Avoiding panics on Gaps- or Dim-access through the layout
+// manager
+type stackingWrapper struct{ *component }
+
+func (sw *stackingWrapper) Gaps() api.Gaps { return gapsOf(sw.component) }
+
 func (sw *stackingWrapper) ForStacked(cb func(lyt.Dimer) bool) {
-	sw.userCmp.(Stacker).ForStacked(func(cmp Componenter) bool {
-		if !cmp.hasLayoutWrapper() {
-			cmp.initialize(
-				cmp,
-				sw.userCmp.backend(),
-				sw.globals().clone(),
-			)
-			if sw.ff.all() != NoFeature {
-				cmp.embedded().layoutCmp.wrapped().ff = sw.ff.copy()
-			}
-		}
-		return cb(cmp.layoutComponent())
-	})
+	sw.forLayoutNested(cb)
 }
 
 // chainingWrapper wraps a chaining user-component for the layout
@@ -403,30 +453,132 @@ func (sw *stackingWrapper) ForStacked(cb func(lyt.Dimer) bool) {
 // manager
 type chainingWrapper struct{ *component }
 
-func (sw *chainingWrapper) Gaps() api.Gaps {
-	if sw.gaps == nil {
-		return api.Gaps{}
+func (sw *chainingWrapper) Gaps() api.Gaps { return gapsOf(sw.component) }
+
+func (cw *chainingWrapper) ForChained(cb func(lyt.Dimer) bool) {
+	cw.forLayoutNested(cb)
+}
+
+// responsiveWrapper wraps a responsive user-component for the layout
+// manager which stacks or chains its nested components according to
+// the breakpoint applied to the layout.
+type responsiveWrapper struct{ *component }
+
+func (rw *responsiveWrapper) Gaps() api.Gaps { return gapsOf(rw.component) }
+
+func (rw *responsiveWrapper) ForStacked(cb func(lyt.Dimer) bool) {
+	rw.forLayoutNested(cb)
+}
+
+func (rw *responsiveWrapper) ForChained(cb func(lyt.Dimer) bool) {
+	rw.forLayoutNested(cb)
+}
+
+// Chaining returns true if the breakpoint applied to the layout of
+// given responsive wrapper rw chains its nested components.
+func (rw *responsiveWrapper) Chaining() bool {
+	bb := rw.userCmp.(Responder).Breakpoints()
+	return rw.layoutBreakpoint >= 0 && rw.layoutBreakpoint < len(bb) &&
+		bb[rw.layoutBreakpoint].Chaining
+}
+
+// gridingWrapper wraps a griding user-component for the layout manager
+// which places its nested components in the cells of its grid.
+type gridingWrapper struct{ *component }
+
+func (gw *gridingWrapper) Gaps() api.Gaps { return gapsOf(gw.component) }
+
+func (gw *gridingWrapper) Tracks() (columns, rows []lyt.Track) {
+	return gw.userCmp.(Grider).Tracks()
+}
+
+func (gw *gridingWrapper) ForGridded(cb func(lyt.Dimer, lyt.Cell) bool) {
+	gw.userCmp.(Grider).ForGridded(func(cmp Componenter, c Cell) bool {
+		lc := gw.nested(cmp)
+		if lc == nil {
+			return false
+		}
+		return cb(lc, c)
+	})
+}
+
+// deckingWrapper wraps a decking user-component for the layout manager
+// which stacks only its shown decked component.
+type deckingWrapper struct {
+	*component
+
+	// shown is the decked component which is currently layed out.
+	shown layoutComponenter
+}
+
+func (dw *deckingWrapper) Gaps() api.Gaps { return gapsOf(dw.component) }
+
+func (dw *deckingWrapper) ForStacked(cb func(lyt.Dimer) bool) {
+	if dw.shown == nil {
+		dw.shown = dw.current()
 	}
-	return api.Gaps{
-		Top:    len(sw.gaps.top.ll),
-		Right:  len(sw.gaps.right.ll),
-		Bottom: len(sw.gaps.bottom.ll),
-		Left:   len(sw.gaps.left.ll),
+	if dw.shown == nil || dw.shown.wrapped().hidden {
+		return
 	}
+	cb(dw.shown)
 }
 
-func (cw *chainingWrapper) ForChained(cb func(lyt.Dimer) bool) {
-	cw.userCmp.(Chainer).ForChained(func(cmp Componenter) bool {
-		if !cmp.hasLayoutWrapper() {
-			cmp.initialize(
-				cmp,
-				cw.userCmp.backend(),
-				cw.globals().clone(),
-			)
-			if cw.ff.all() != NoFeature {
-				cmp.embedded().layoutCmp.wrapped().ff = cw.ff.copy()
-			}
+// current returns the decked component which is to be shown while it
+// wraps all decked components for the layout.
+func (dw *deckingWrapper) current() (shown layoutComponenter) {
+	idx, i := dw.userCmp.(Decker).Current(), 0
+	dw.userCmp.(Decker).ForDecked(func(cmp Componenter) bool {
+		dw.nested(cmp)
+		if i == idx {
+			shown = cmp.layoutComponent()
+		}
+		i++
+		return false
+	})
+	return shown
+}
+
+// forLayoutNested calls back for the layout components of the stacked,
+// chained respectively viewported components of given container
+// component c which are not hidden.
+func (c *component) forLayoutNested(cb func(lyt.Dimer) bool) {
+	var forEach func(func(Componenter) (stop bool))
+	var hidden map[int]bool
+	switch usr := c.userCmp.(type) {
+	case Responder:
+		forEach, hidden = usr.ForResponsive, c.responsiveHidden(usr)
+	case Stacker:
+		forEach = usr.ForStacked
+	case Chainer:
+		forEach = usr.ForChained
+	case Viewporter:
+		forEach = usr.ForViewported
+	default:
+		return
+	}
+	i := -1
+	forEach(func(cmp Componenter) bool {
+		i++
+		lc := c.nested(cmp)
+		if lc == nil || hidden[i] {
+			return false
 		}
-		return cb(cmp.layoutComponent())
+		return cb(lc)
 	})
 }
+
+// nested initializes given nested component cmp of given container
+// component c if it isn't already and returns its layout component or
+// nil if cmp is hidden.
+func (c *component) nested(cmp Componenter) layoutComponenter {
+	if !cmp.hasLayoutWrapper() {
+		cmp.initialize(cmp, c.userCmp.backend(), c.globals().clone())
+		if c.ff.all() != NoFeature {
+			cmp.embedded().layoutCmp.wrapped().ff = c.ff.copy()
+		}
+	}
+	if cmp.layoutComponent().wrapped().hidden {
+		return nil
+	}
+	return cmp.layoutComponent()
+}
@@ -0,0 +1,68 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package lines
+
+import "github.com/slukits/lines/internal/lyt"
+
+// syncDecks lays out the current decked component of each decking
+// component of the layout whose current component has changed and
+// returns true if there was any such switch.  Is the focus inside the
+// previously shown component it is moved to the newly shown one
+// whereas the focus loss of the former and the focus gain of the later
+// are reported; the latter after a first time shown component was
+// reported its initialization.
+func (s *screen) syncDecks(ll *Lines) (switched bool) {
+	cntx, dd := &rprContext{ll: ll, scr: s}, []*deckingWrapper{}
+	s.lyt.ForDimer(nil, func(d lyt.Dimer) (stop bool) {
+		if dw, ok := d.(*deckingWrapper); ok {
+			dd = append(dd, dw)
+		}
+		return false
+	})
+	for _, dw := range dd {
+		shown := dw.current()
+		if shown == dw.shown {
+			continue
+		}
+		switched = true
+		focused := dw.shown != nil && s.lyt.Has(s.focus, dw.shown)
+		if focused {
+			moveFocus(dw.userCmp, cntx)
+		}
+		dw.shown = shown
+		if shown == nil {
+			continue
+		}
+		shown.wrapped().SetDirty()
+		if !focused {
+			continue
+		}
+		if !shown.userComponent().isInitialized() {
+			reportInit(ll, s)
+		}
+		moveFocus(shown.userComponent(), cntx)
+	}
+	return switched
+}
+
+// isDecked returns true iff given layout component lc is nested in a
+// hidden decked component of a decking component of the layout.
+func (s *screen) isDecked(lc layoutComponenter) (decked bool) {
+	s.lyt.ForDimer(nil, func(d lyt.Dimer) (stop bool) {
+		dw, ok := d.(*deckingWrapper)
+		if !ok {
+			return false
+		}
+		dw.userCmp.(Decker).ForDecked(func(cmp Componenter) (stop bool) {
+			if !cmp.hasLayoutWrapper() || cmp.layoutComponent() == dw.shown {
+				return false
+			}
+			decked = s.lyt.Has(lc, cmp.layoutComponent())
+			return decked
+		})
+		return decked
+	})
+	return decked
+}
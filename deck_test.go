@@ -0,0 +1,123 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package lines
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	. "github.com/slukits/gounit"
+)
+
+type _decking struct{ Suite }
+
+func (s *_decking) SetUp(t *T) { t.Parallel() }
+
+// deckFX returns a decking of three components printing their index.
+func deckFX() (*deckingFX, []*cmpFX) {
+	cc, deck := []*cmpFX{}, &deckingFX{}
+	for i := 0; i < 3; i++ {
+		i := i
+		cc = append(cc, &cmpFX{onInit: func(c *cmpFX, e *Env) {
+			fmt.Fprintf(e, "page %d", i)
+		}})
+		deck.CC = append(deck.CC, cc[i])
+	}
+	return deck, cc
+}
+
+func (s *_decking) Shows_current_component_only(t *T) {
+	deck, cc := deckFX()
+	fx := fx(t, deck)
+	t.Eq("page 0", fx.Screen().Trimmed().String())
+	x, y, w, h := fx.Dim(cc[0]).Printable()
+	t.True(x == 0 && y == 0 && w == 80 && h == 25)
+	t.Eq(0, cc[1].N(onInit))
+	t.FatalOn(fx.Lines.Update(deck, nil, func(*Env) { deck.Show(2) }))
+	t.Eq("page 2", fx.Screen().Trimmed().String())
+	t.Eq(2, deck.Current())
+}
+
+func (s *_decking) Ignores_index_outside_decked_components(t *T) {
+	deck, _ := deckFX()
+	fx := fx(t, deck)
+	t.FatalOn(fx.Lines.Update(deck, nil, func(*Env) {
+		deck.Show(3)
+		deck.Show(-1)
+	}))
+	t.Eq(0, deck.Current())
+	t.Eq("page 0", fx.Screen().Trimmed().String())
+}
+
+func (s *_decking) Keeps_hidden_components_initialized(t *T) {
+	deck, cc := deckFX()
+	fx := fx(t, deck)
+	t.FatalOn(fx.Lines.Update(cc[0], nil, func(e *Env) {
+		fmt.Fprint(e, "page 0 updated")
+	}))
+	t.FatalOn(fx.Lines.Update(deck, nil, func(*Env) { deck.Show(1) }))
+	t.Eq("page 1", fx.Screen().Trimmed().String())
+	t.FatalOn(fx.Lines.Update(deck, nil, func(*Env) { deck.Show(0) }))
+	t.Eq("page 0 updated", fx.Screen().Trimmed().String())
+	t.Eq(1, cc[0].N(onInit))
+	t.Eq(1, cc[1].N(onInit))
+}
+
+func (s *_decking) Moves_focus_to_newly_shown_component(t *T) {
+	deck, cc := deckFX()
+	fx := fx(t, deck)
+	t.FatalOn(fx.Lines.Focus(cc[0]))
+	t.Eq(1, cc[0].N(onFocus))
+	t.FatalOn(fx.Lines.Update(deck, nil, func(*Env) { deck.Show(1) }))
+	t.Eq(1, cc[0].N(onFocusLost))
+	t.Eq(1, cc[1].N(onFocus))
+	t.FatalOn(fx.Lines.Update(cc[1], nil, func(e *Env) {
+		t.True(e.Focused() == cc[1])
+	}))
+}
+
+func (s *_decking) Initializes_newly_shown_component_before_focusing(
+	t *T,
+) {
+	deck, cc := deckFX()
+	order := []string{}
+	cc[1].onInit = func(*cmpFX, *Env) { order = append(order, "init") }
+	cc[1].onFocus = func(*cmpFX, *Env) { order = append(order, "focus") }
+	fx := fx(t, deck)
+	t.FatalOn(fx.Lines.Focus(cc[0]))
+	t.FatalOn(fx.Lines.Update(deck, nil, func(*Env) { deck.Show(1) }))
+	t.Eq([]string{"init", "focus"}, order)
+}
+
+func (s *_decking) Keeps_focus_outside_the_switched_component(t *T) {
+	deck, cc := deckFX()
+	fx := fx(t, deck)
+	t.FatalOn(fx.Lines.Update(deck, nil, func(*Env) { deck.Show(1) }))
+	t.Eq(0, cc[0].N(onFocusLost))
+	t.Eq(0, cc[1].N(onFocus))
+}
+
+func (s *_decking) Keeps_tasks_of_hidden_components(t *T) {
+	deck, cc := deckFX()
+	fx := fx(t, deck)
+	var ctx context.Context
+	started := make(chan struct{})
+	cancel := fx.Lines.Go(cc[0], func(c context.Context) (any, error) {
+		ctx = c
+		close(started)
+		<-c.Done()
+		return nil, c.Err()
+	}, nil)
+	<-started
+	t.FatalOn(fx.Lines.Update(deck, nil, func(*Env) { deck.Show(1) }))
+	t.FatalOn(ctx.Err())
+	cancel()
+}
+
+func TestDecking(t *testing.T) {
+	t.Parallel()
+	Run(&_decking{}, t)
+}
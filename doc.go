@@ -41,8 +41,12 @@ provided components embedding the [Component]-type.  While client
 listener implementations print to an provided environment [Env] which is
 associated with the component's portion of the screen.  lines is
 designed to add further backends like "shiny" or "fyne" for graphical
-displays.  As of now lines has only a terminal backend which is a
-wrapper around [tcell].
+displays.  As of now lines comes only with a terminal backend which is a
+wrapper around [tcell].  Further backends implementing the [Backend]
+contract may be used with the [New] constructor; the backendtest package
+provides a conformance test suite for such implementations.  [Headless]
+runs an application on an in-memory screen without a terminal while
+[TermOn] runs it on an arbitrary tty, e.g. of a ssh-session.
 
 Above "hello world"-program takes over a terminal screen printing
 horizontally and vertically centered "hello world" to it.  "hello world"
@@ -76,9 +80,17 @@ what does work
 	    }(e.Lines)
 	}
 
-Also using functionality or properties provided by embedded [Component]
-instance in a function that doesn't return in the executing listener
-won't work.
+respectively without a hand-rolled go routine
+
+	func (c *Cmp) OnInit(e *lines.Env) {
+	    e.Lines.After(c, 1*time.Second, func(e *lines.Env) {
+	        fmt.Fprint(e, "awoken") // will not panic
+	    })
+	}
+
+see [Lines.After] and [Lines.Tick].  Also using functionality or
+properties provided by embedded [Component] instance in a function that
+doesn't return in the executing listener won't work.
 
 	func (c *Cmp) OnInit(e *lines.Env) {
 	    go func() {
@@ -90,7 +102,10 @@ won't work.
 
 It is only save to pass (the initially created) [Lines] instance on to a
 go routine where at the end provided update mechanisms of said
-Lines-instance are used to report back to a component.
+Lines-instance are used to report back to a component.  [Lines.Go]
+runs such a go routine and reports its result back to a component; its
+work is canceled if the component is removed from the layout or the
+Lines instance is quit.
 
 # Event handling
 
@@ -112,10 +127,36 @@ provides features to change the later.  One can also control there if a
 component is filling, i.e.  uses up unused space, or if its size is
 fixed.  Components can be arbitrarily nested by embedding either the
 [Stacking] or [Chaining] type in a component or by implementing either
-the [Stacker] or [Chainer] interface.  Finally components can be
-[Component.Layered] by other components which makes it possible to
-implement tooltip, context menu, menu bar or modal dialogs.  See
-[examples/layers] for how to work with layers.
+the [Stacker] or [Chainer] interface.  Setting the [Splittable] feature
+lets the user resize the nested components of a stacking or chaining
+component by mouse or keyboard.  Components whose columns and rows need
+to be aligned are arranged in a grid by embedding the [Griding] type or
+by implementing the [Grider] interface.  Components at explicit
+positions inside their container, e.g. the nodes of a graph, are
+arranged by embedding the [Placing] type or by implementing the
+[Placer] interface.  Of the components of a [Decking] component
+respectively [Decker] implementation only one is shown at a time, e.g.
+for tab views or wizards.  A [Responsive] component respectively
+[Responder] implementation switches between stacking and chaining its
+components or hides some of them depending on the space it gets on the
+screen.  Nested components may be removed
+temporarily from their container's layout by [Component.Hide], e.g.
+to collapse a side panel.  A [Viewporting] component respectively
+[Viewporter] implementation stacks more components than fit on the
+screen, e.g. for long forms, and scrolls them into view.  Finally
+components can be [Component.Layered] by other components which makes
+it possible to implement tooltip, context menu, menu bar or modal
+dialogs.  A layer may be placed next to a component or screen cell using
+[NewAnchoredLayerPos] respectively [NewCellAnchoredLayerPos] and put
+above or below other layers by [Component.RaiseLayer] respectively
+[Component.LowerLayer].  See [examples/layers] for how to work with
+layers.
+
+To debug a layout [Lines.DumpLayout] writes the component tree with
+each component's screen area, printable area, filling settings, margins
+and clippings followed by the layers in their z-order while
+[Lines.Inspect] outlines the component under the mouse and shows its
+layout information on the screen.
 
 # Content and format handling
 
@@ -130,9 +171,23 @@ printer where and how. e.g.:
 
 The above prints "a centered bold line" centered in bold letters into
 the component's fifth line.  Note the line will stay centered if the
-component's size changes.  If there should be many lines associated with
-a component c without storing them in the component a component's
-[ContentSource] can be set:
+component's size changes.  Output of other programs, e.g. of a compiler,
+colored by ANSI escape sequences is printed in its colors to the writer
+returned by [Env.ANSI].  A line wider than its component is truncated
+unless the component's lines are wrapped:
+
+	c.LL.Mod(lines.WordWrapping)
+
+Then a line is displayed over as many screen lines as needed and
+scrolling, line focus and cursor movement take these screen lines into
+account.  Note positions and style ranges of a line are screen cells.
+A cell displays a grapheme cluster, e.g. a letter with its combining
+accent or an emoji sequence, which is never split by truncation,
+highlighting, cursor movement or editing while clusters starting with
+a wide rune like East Asian characters or most emojis take two cells.
+If there should be many
+lines associated with a component c without storing them in the
+component a component's [ContentSource] can be set:
 
 	c.Src = &lines.ContentSource{Liner: MyLinerImplementation}
 
@@ -236,6 +291,9 @@ Fixture-instance are:
 
   - providing the screen's content and its styles.
 
+  - dumping the layout of the component tree, see
+    [Fixture.DumpLayout].
+
 Enjoy!
 
 [examples/layers]: https://github.com/slukits/lines/tree/main/examples/layers
@@ -19,6 +19,10 @@ type Edit struct {
 	Cell int
 	Type EditType
 	Rune rune
+
+	// Text is set to the pasted text of a bracketed paste which is
+	// reported as a single edit, in which case Rune is the zero rune.
+	Text string
 }
 
 // Editer implementations are informed about user edits of a components
@@ -95,6 +99,8 @@ func (e *Editor) MapEvent(evt KeyEventer) *Edit {
 // line joining Edit-instances are returned.  Is the cursor at the first
 // content cell and received key is Backspace respectively at the last
 // content cell and teh received key is Delete nil is returned.
+// Backspace deletes the grapheme cluster preceding the cursor, i.e. the
+// Edit's Cell is the first cell of that cluster.
 func (e *Editor) delEdit(evt KeyEventer) *Edit {
 	cmp := e.c.layoutCmp.wrapped()
 	ln, cl, haveCursor := cmp.cursorPosition()
@@ -118,7 +124,7 @@ func (e *Editor) delEdit(evt KeyEventer) *Edit {
 	}
 	if edt.Type == 0 {
 		if key == Backspace {
-			edt.Cell--
+			edt.Cell = (*cmp.ll)[ln].previousCell(cl)
 		}
 		edt.Type = Del
 	}
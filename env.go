@@ -102,6 +102,13 @@ func (e *Env) BG(color Color) *EnvWriter {
 	return &EnvWriter{cmp: e.cmp, sty: &sty}
 }
 
+// ANSI returns a writer which translates ANSI SGR escape sequences of
+// its writes into styles, see [EnvANSIWriter].  Like [Env.Write] a
+// write replaces all previous content of the component.
+func (e *Env) ANSI() *EnvANSIWriter {
+	return newEnvANSIWriter(e.cmp, -1, nil, false)
+}
+
 // LL returns a writer which writes to the line and its following lines
 // at given index.
 func (e *Env) LL(idx int) *EnvLineWriter {
@@ -60,6 +60,13 @@ func (w *EnvWriter) LL(idx int) *EnvLineWriter {
 	return &EnvLineWriter{line: idx, cmp: w.cmp, sty: w.sty}
 }
 
+// ANSI returns a writer which translates ANSI SGR escape sequences of
+// its writes into styles on top of given writer w's style, see
+// [EnvANSIWriter].
+func (w *EnvWriter) ANSI() *EnvANSIWriter {
+	return newEnvANSIWriter(w.cmp, 0, w.sty, false)
+}
+
 // Write to a components screen-portion made available by an Env
 // instance provided to a listener implementation.
 func (w *EnvWriter) Write(bb []byte) (int, error) {
@@ -133,6 +140,13 @@ func (w *EnvLineWriter) Write(bb []byte) (int, error) {
 	return w.cmp.embedded().write(bb, w.line, -1, w.sty)
 }
 
+// ANSI returns a writer which translates ANSI SGR escape sequences of
+// its writes into styles on top of given line writer w's style while
+// it writes to w's line and its following lines, see [EnvANSIWriter].
+func (w *EnvLineWriter) ANSI() *EnvANSIWriter {
+	return newEnvANSIWriter(w.cmp, w.line, w.sty, w.inner)
+}
+
 // At returns a writer which writes at given line writer w's line at
 // given cell.  Note you need to use the [lines.Print]-function to print
 // to an at-writer and can only provide a rune or a rune-slice.  Styles
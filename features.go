@@ -496,7 +496,8 @@ var allFeatures = []FeatureMask{
 	PreviousLineFocusable, NextLineFocusable, PreviousCellFocusable,
 	NextCellFocusable, FirstCellFocusable, LastCellFocusable,
 	LineSelectable, LineUnfocusable, HighlightEnabled,
-	TrimmedHighlightEnabled, editable,
+	TrimmedHighlightEnabled, editable, splitDraggable,
+	SplitBackwardMovable, SplitForwardMovable,
 }
 
 type bindings struct {
@@ -518,6 +519,13 @@ var defaultBindings = map[FeatureMask]*bindings{
 			Mod:    ZeroModifier,
 		}},
 	},
+	FocusMovable: {
+		kk: FeatureKeys{
+			{Key: Tab, Mod: ZeroModifier},
+			{Key: Backtab, Mod: ZeroModifier},
+			{Key: Backtab, Mod: Shift},
+		},
+	},
 	UpScrollable: {
 		kk: FeatureKeys{{
 			Key: PgUp,
@@ -562,4 +570,10 @@ var defaultBindings = map[FeatureMask]*bindings{
 	editable: {
 		kk: FeatureKeys{{Key: Insert, Mod: ZeroModifier}},
 	},
+	SplitBackwardMovable: {
+		kk: FeatureKeys{{Key: Up, Mod: Alt}, {Key: Left, Mod: Alt}},
+	},
+	SplitForwardMovable: {
+		kk: FeatureKeys{{Key: Down, Mod: Alt}, {Key: Right, Mod: Alt}},
+	},
 }
@@ -5,9 +5,13 @@
 package lines
 
 import (
+	"encoding/json"
+	"io"
+	"strings"
 	"sync"
 	"testing"
 	"time"
+	"unicode/utf8"
 
 	"github.com/slukits/lines/internal/api"
 	"github.com/slukits/lines/internal/lyt"
@@ -85,10 +89,7 @@ func TermFixture(
 	t.Helper()
 	ll := &Lines{}
 	ui, backend := term.NewFixture(t, timeout)
-	ll.Globals = newGlobals(nil)
-	ll.scr = newScreen(ui, c, ll.Globals)
-	ll.Globals.propagation = globalsPropagationClosure(ll.scr)
-	ll.backend = ui
+	ll.init(ui, c, true)
 	ll.Quitting = &quitting{Mutex: &sync.Mutex{}}
 	ll.Quitting.AddRune('q')
 	tt := &Fixture{
@@ -117,6 +118,12 @@ func (fx *Fixture) Dim(c Componenter) (d *lyt.Dim) {
 	return d
 }
 
+// DumpLayout writes the layout of fixture fx's Lines instance to given
+// writer w, see [Lines.DumpLayout].
+func (fx *Fixture) DumpLayout(w io.Writer) error {
+	return fx.Lines.DumpLayout(w)
+}
+
 // FireResize posts a resize event and returns after this event has been
 // processed.  NOTE this event as such is not reported but it triggers
 // OnInit and OnLayout events of components which are not initialized or
@@ -130,6 +137,23 @@ func (fx *Fixture) FireResize(width, height int) *Fixture {
 	return fx
 }
 
+// Advance moves the clock driving the timers of the fixture's Lines
+// instance by given duration d.  Each timer created by [Lines.After] or
+// [Lines.Tick] expiring in that time span is reported in the order of
+// expiration.  Advance returns after all timer events and subsequently
+// triggered events have been processed.  Note a fixture's timers don't
+// run on their own, i.e. they only expire by calling Advance.
+func (fx *Fixture) Advance(d time.Duration) *Fixture {
+	fx.t.Helper()
+	if d <= 0 {
+		return fx
+	}
+	if err := fx.Lines.timers.advance(d); err != nil {
+		fx.t.Fatalf("fixture: advance: %v", err)
+	}
+	return fx
+}
+
 // FireRune posts given run-key-press event and returns after this event
 // has been processed.
 func (fx *Fixture) FireRune(r rune, m ...ModifierMask) *Fixture {
@@ -142,6 +166,91 @@ func (fx *Fixture) FireRune(r rune, m ...ModifierMask) *Fixture {
 	return fx
 }
 
+// FirePaste posts given text as bracketed paste and returns after the
+// paste has been processed.
+func (fx *Fixture) FirePaste(text string) *Fixture {
+	fx.t.Helper()
+	fx.PostBracketPaste(text)
+	return fx
+}
+
+// Replay fires the events recorded by [Lines.Record] which are read
+// from given reader r in the recorded order and returns after all
+// events have been processed.  Before an event is fired the fixture's
+// clock is advanced by the time span which passed between the recorded
+// events, i.e. timers expire in a replay as they did in the recorded
+// session.  A bracketed paste is fired as a whole once its end is read,
+// see [Fixture.FirePaste].
+func (fx *Fixture) Replay(r io.Reader) *Fixture {
+	fx.t.Helper()
+	dec, ms := json.NewDecoder(r), int64(0)
+	var paste *strings.Builder
+	for {
+		re := RecordedEvent{}
+		if err := dec.Decode(&re); err != nil {
+			if err == io.EOF {
+				return fx
+			}
+			fx.t.Fatalf("fixture: replay: %v", err)
+		}
+		if re.MS > ms {
+			fx.Advance(time.Duration(re.MS-ms) * time.Millisecond)
+			ms = re.MS
+		}
+		if paste != nil {
+			switch {
+			case re.Type == RecordedRune:
+				paste.WriteString(re.Rune)
+			case re.Type == RecordedKey && re.Key == api.Enter:
+				paste.WriteRune('\n')
+			case re.Type == RecordedKey && re.Key == api.Tab:
+				paste.WriteRune('\t')
+			case re.Type == RecordedPasteEnd:
+				fx.FirePaste(paste.String())
+				paste = nil
+			}
+			continue
+		}
+		switch re.Type {
+		case RecordedKey:
+			fx.FireKey(re.Key, re.Mod)
+		case RecordedRune:
+			r, _ := utf8.DecodeRuneInString(re.Rune)
+			fx.FireRune(r, re.Mod)
+		case RecordedMouse:
+			fx.FireMouse(re.X, re.Y, re.Button, re.Mod)
+		case RecordedResize:
+			fx.FireResize(re.Width, re.Height)
+		case RecordedPasteStart:
+			paste = &strings.Builder{}
+		case RecordedPasteEnd:
+		default:
+			fx.t.Fatalf("fixture: replay: unknown event type: %s",
+				re.Type)
+		}
+	}
+}
+
+// FireSuspend suspends the fixture's Lines instance executing given
+// function run while suspended and returns after the screen was resumed
+// and all subsequently triggered events have been processed.  To
+// simulate an external program using the terminal the screen is
+// cleared before run is executed.  See [Lines.Suspend].
+func (fx *Fixture) FireSuspend(run func() error) *Fixture {
+	fx.t.Helper()
+	err := fx.Lines.Suspend(func() error {
+		fx.ClearScreen()
+		if run == nil {
+			return nil
+		}
+		return run()
+	})
+	if err != nil {
+		fx.t.Fatalf("fixture: suspend: %v", err)
+	}
+	return fx
+}
+
 // FireKey posts given special-key event and returns after this
 // event has been processed.
 func (fx *Fixture) FireKey(k api.Key, m ...ModifierMask) *Fixture {
@@ -8,17 +8,17 @@ require (
 	github.com/gdamore/tcell/v2 v2.6.0
 	github.com/jackdoe/go-gpmctl v0.0.0-20221007100923-dc00b863cb22
 	github.com/mattn/go-isatty v0.0.17
+	github.com/mattn/go-runewidth v0.0.14
+	github.com/rivo/uniseg v0.4.4
 	github.com/slukits/gounit v0.8.3
 	github.com/slukits/ints v0.0.0-20221112103347-af0b55a6436b
+	golang.org/x/term v0.5.0
 )
 
 require (
 	github.com/gdamore/encoding v1.0.0 // indirect
 	github.com/google/go-cmp v0.5.9 // indirect
 	github.com/lucasb-eyer/go-colorful v1.2.0 // indirect
-	github.com/mattn/go-runewidth v0.0.14 // indirect
-	github.com/rivo/uniseg v0.4.4 // indirect
 	golang.org/x/sys v0.5.0 // indirect
-	golang.org/x/term v0.5.0 // indirect
 	golang.org/x/text v0.7.0 // indirect
 )
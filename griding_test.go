@@ -0,0 +1,99 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package lines
+
+import (
+	"testing"
+
+	. "github.com/slukits/gounit"
+)
+
+type _griding struct{ Suite }
+
+func (s *_griding) SetUp(t *T) { t.Parallel() }
+
+// gridFX returns a griding component with a fixed 20 columns wide first
+// column, a filling second column, a fixed 5 lines high first row and a
+// filling second row.  The first gridded component spans the first
+// row; the other two are placed in the second row.
+func gridFX() (*gridingFX, []*cmpFX) {
+	cc := []*cmpFX{{}, {}, {}}
+	return &gridingFX{Griding: Griding{
+		Columns: []Track{TrackFixed(20), TrackFilling(1)},
+		Rows:    []Track{TrackFixed(5), TrackFilling(1)},
+		CC: []GridCell{
+			{Cmp: cc[0], Cell: Cell{ColumnSpan: 2}},
+			{Cmp: cc[1], Cell: Cell{Row: 1}},
+			{Cmp: cc[2], Cell: Cell{Column: 1, Row: 1}},
+		},
+	}}, cc
+}
+
+func (s *_griding) Layouts_gridded_components_in_aligned_tracks(t *T) {
+	grid, cc := gridFX()
+	fx := fx(t, grid)
+	exp := [][4]int{{0, 0, 80, 5}, {0, 5, 20, 20}, {20, 5, 60, 20}}
+	for i, c := range cc {
+		x, y, w, h := fx.Dim(c).Printable()
+		t.Eq(exp[i], [4]int{x, y, w, h})
+	}
+	fx.FireResize(60, 15)
+	exp = [][4]int{{0, 0, 60, 5}, {0, 5, 20, 10}, {20, 5, 40, 10}}
+	for i, c := range cc {
+		x, y, w, h := fx.Dim(c).Printable()
+		t.Eq(exp[i], [4]int{x, y, w, h})
+	}
+}
+
+func (s *_griding) Reports_clicks_to_gridded_components(t *T) {
+	grid, cc := gridFX()
+	clicked := []int{}
+	for i, c := range cc {
+		i := i
+		c.onMouse = func(_ *cmpFX, _ *Env, bm ButtonMask, _, _ int) {
+			if bm == Primary {
+				clicked = append(clicked, i)
+			}
+		}
+	}
+	fx := fx(t, grid)
+	fx.FireClick(30, 10).FireClick(10, 10).FireClick(10, 2)
+	t.Eq([]int{2, 1, 0}, clicked)
+}
+
+func (s *_griding) Reports_focus_to_griding_parent(t *T) {
+	grid, cc := gridFX()
+	fx := fx(t, grid)
+	t.FatalOn(fx.Lines.Focus(cc[2]))
+	t.Eq(1, cc[2].N(onFocus))
+	t.FatalOn(fx.Lines.Focus(cc[1]))
+	t.Eq(1, cc[2].N(onFocusLost))
+	t.Eq(1, cc[1].N(onFocus))
+}
+
+func (s *_griding) Ignores_cursor_sets(t *T) {
+	grid, _ := gridFX()
+	fx := fx(t, grid)
+	fx.Lines.Update(grid, nil, func(e *Env) {
+		grid.SetCursor(0, 0)
+	})
+	t.Eq(Componenter(nil), fx.Lines.CursorComponent())
+}
+
+func (s *_griding) Flags_components_outside_its_tracks_off_screen(
+	t *T,
+) {
+	grid, _ := gridFX()
+	outside := &cmpFX{}
+	grid.CC = append(grid.CC, GridCell{
+		Cmp: outside, Cell: Cell{Column: 2}})
+	fx := fx(t, grid)
+	t.True(fx.Dim(outside).IsOffScreen())
+}
+
+func TestGriding(t *testing.T) {
+	t.Parallel()
+	Run(&_griding{}, t)
+}
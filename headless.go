@@ -0,0 +1,92 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package lines
+
+import (
+	"sync"
+
+	"github.com/slukits/lines/internal/term"
+)
+
+// HeadlessLines is a [Lines] instance whose backend is an in-memory
+// screen instead of a terminal, see [Headless].  Next to the features
+// of a Lines instance it provides the means to emulate user input and
+// to take snapshots of the in-memory screen.
+type HeadlessLines struct {
+	*Lines
+	ui *term.UI
+}
+
+// Headless returns a [HeadlessLines] instance displaying and reporting
+// events to given component cmp and its nested components on an
+// in-memory screen of given width and height.  The event-loop
+// semantics are the same as of an instance returned by [Term], i.e.
+// posting an event returns before the event was processed and 'q',
+// ctrl-c and ctrl-d terminate the event-loop.  Use
+// [HeadlessLines.Sync] to wait for the processing of posted events
+// before taking a snapshot of the screen:
+//
+//	hl := lines.Headless(&myComponent{}, 80, 25)
+//	hl.PostRune('x', lines.ZeroModifier)
+//	hl.Sync()
+//	fmt.Println(hl.Screen().Trimmed())
+//	hl.Quit()
+//
+// Headless is meant for running lines applications where no terminal
+// is available, e.g. on a server or in a CI pipeline.
+func Headless(cmp Componenter, width, height int) *HeadlessLines {
+	ui := term.NewHeadless(width, height)
+	ll := &Lines{}
+	ll.init(ui, cmp, false)
+	ll.Quitting = &quitting{Mutex: &sync.Mutex{}}
+	ll.Quitting.AddRune('q')
+	ui.Listen(ll.listen)
+	return &HeadlessLines{Lines: ll, ui: ui}
+}
+
+// Sync blocks until all events posted before Sync was called have been
+// processed or the event-loop was quit.  Sync must not be called from
+// within a listener.
+func (hl *HeadlessLines) Sync() { hl.ui.Sync() }
+
+// PostKey emulates a user special-key input.  PostKey fails if the
+// event-loop is full.
+func (hl *HeadlessLines) PostKey(k Key, m ModifierMask) error {
+	return hl.ui.PostKey(k, m)
+}
+
+// PostRune emulates a user rune input.  PostRune fails if the
+// event-loop is full.
+func (hl *HeadlessLines) PostRune(r rune, m ModifierMask) error {
+	return hl.ui.PostRune(r, m)
+}
+
+// PostMouse emulates a user mouse input at given coordinates.
+// PostMouse fails if the event-loop is full.
+func (hl *HeadlessLines) PostMouse(
+	x, y int, b ButtonMask, m ModifierMask,
+) error {
+	return hl.ui.PostMouse(x, y, b, m)
+}
+
+// PostResize changes the size of the in-memory screen and reports the
+// change to the component tree.  PostResize fails if the event-loop is
+// full.
+func (hl *HeadlessLines) PostResize(width, height int) error {
+	return hl.ui.PostResize(width, height)
+}
+
+// Screen returns a snapshot of the in-memory screen's content as it
+// was made visible by the latest processed event.  The snapshot is
+// taken on the event-loop after the events posted before Screen was
+// called were processed, i.e. Screen must not be called from within a
+// listener.
+func (hl *HeadlessLines) Screen() StringScreen { return hl.ui.Screen() }
+
+// Cells returns a snapshot of the in-memory screen's content along
+// with its styles as it was made visible by the latest processed event.
+// Like [HeadlessLines.Screen] Cells must not be called from within a
+// listener.
+func (hl *HeadlessLines) Cells() CellsScreen { return hl.ui.Cells() }
@@ -0,0 +1,157 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package lines
+
+import "github.com/slukits/lines/internal/lyt"
+
+// Hider is implemented by components which want to be informed when
+// they are hidden, see [Component.Hide].
+type Hider interface {
+
+	// OnHide is called back after an implementing component was
+	// removed from the layout of its container.
+	OnHide(*Env)
+}
+
+// Shower is implemented by components which want to be informed when
+// they are shown again after they were hidden, see [Component.Show].
+type Shower interface {
+
+	// OnShow is called back after an implementing hidden component was
+	// put back into the layout of its container.
+	OnShow(*Env)
+}
+
+// Hide removes a stacked, chained, gridded, placed or decked component
+// temporarily from the layout of its container without loosing its
+// state, i.e. it takes no space, isn't found at screen positions, can't
+// be focused and doesn't receive bubbling events until it is shown
+// again.  The focus of a hidden component moves to its container.
+// Hide takes effect after the current event was processed and is
+// reported to [Hider] implementations.
+func (c *component) Hide() {
+	if c.hide {
+		return
+	}
+	c.hide = true
+	c.gg.scr.toggled = append(c.gg.scr.toggled, c)
+}
+
+// Show puts a hidden component back into the layout of its container,
+// see [Component.Hide].  Show takes effect after the current event was
+// processed and is reported to [Shower] implementations.
+func (c *component) Show() {
+	if !c.hide {
+		return
+	}
+	c.hide = false
+	c.gg.scr.toggled = append(c.gg.scr.toggled, c)
+}
+
+// IsHidden returns true if a component was hidden, see
+// [Component.Hide].
+func (c *component) IsHidden() bool { return c.hide }
+
+// syncHidden removes components from the layout which were hidden and
+// puts components back which were shown since the last sync and
+// returns true if there were any such components.  Is the focus inside
+// a hidden component it is moved to its container.
+func (s *screen) syncHidden(ll *Lines) (toggled bool) {
+	cntx, cc := &rprContext{ll: ll, scr: s}, s.toggled
+	s.toggled = nil
+	s.pruneHidden()
+	for _, c := range cc {
+		if c.hide == c.hidden {
+			continue
+		}
+		toggled = true
+		lc := c.userCmp.layoutComponent()
+		if !c.hide {
+			c.hidden = false
+			delete(s.hidden, c)
+			c.SetDirty()
+			if shw, ok := c.userCmp.(Shower); ok {
+				callback(c.userCmp, cntx, shw.OnShow)
+			}
+			continue
+		}
+		path, err := s.lyt.Locate(lc)
+		if err == nil && len(path) > 0 && s.lyt.Has(s.focus, lc) {
+			moveFocus(path[len(path)-1].(layoutComponenter).
+				userComponent(), cntx)
+		}
+		c.hidden = true
+		if s.hidden == nil {
+			s.hidden = map[*component]bool{}
+		}
+		s.hidden[c] = true
+		if hdr, ok := c.userCmp.(Hider); ok {
+			callback(c.userCmp, cntx, hdr.OnHide)
+		}
+	}
+	return toggled
+}
+
+// pruneHidden forgets the hidden components which were removed from
+// their container, i.e. which are no longer nested in the layout's root
+// or in a layer's root.
+func (s *screen) pruneHidden() {
+	if len(s.hidden) == 0 {
+		return
+	}
+	nested := map[*component]bool{}
+	var walk func(Componenter) bool
+	walk = func(cmp Componenter) (stop bool) {
+		if !cmp.hasLayoutWrapper() {
+			return false
+		}
+		if c := cmp.layoutComponent().wrapped(); s.hidden[c] {
+			nested[c] = true
+		}
+		forNested(cmp, walk)
+		return false
+	}
+	walk(s.lyt.Root.(layoutComponenter).userComponent())
+	s.lyt.Layers.For(func(l *lyt.Layer) (stop bool) {
+		return walk(l.Root.(layoutComponenter).userComponent())
+	})
+	for c := range s.hidden {
+		if !nested[c] {
+			delete(s.hidden, c)
+		}
+	}
+}
+
+// forNested calls back for each component nested in given component
+// cmp regardless if it is hidden, decked or layed out.
+func forNested(cmp Componenter, cb func(Componenter) (stop bool)) {
+	switch usr := cmp.(type) {
+	case Responder:
+		usr.ForResponsive(cb)
+	case Stacker:
+		usr.ForStacked(cb)
+	case Chainer:
+		usr.ForChained(cb)
+	case Grider:
+		usr.ForGridded(func(c Componenter, _ Cell) bool { return cb(c) })
+	case Placer:
+		usr.ForPlaced(func(c Componenter, _ Place) bool { return cb(c) })
+	case Decker:
+		usr.ForDecked(cb)
+	case Viewporter:
+		usr.ForViewported(cb)
+	}
+}
+
+// isHidden returns true iff given layout component lc is a hidden
+// component or nested in a hidden component.
+func (s *screen) isHidden(lc layoutComponenter) bool {
+	for c := range s.hidden {
+		if s.lyt.Has(lc, c.userCmp.layoutComponent()) {
+			return true
+		}
+	}
+	return s.isResponsiveHidden(lc)
+}
@@ -0,0 +1,110 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package lines
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	. "github.com/slukits/gounit"
+)
+
+type _hide struct{ Suite }
+
+func (s *_hide) SetUp(t *T) { t.Parallel() }
+
+// hidingFX returns a stacking of a hider printing "hider" above a
+// component printing "other".
+func hidingFX() (*stackingFX, *hiderFX, *cmpFX) {
+	hdr := &hiderFX{cmpFX{onInit: func(c *cmpFX, e *Env) {
+		fmt.Fprint(e, "hider")
+	}}}
+	other := &cmpFX{onInit: func(c *cmpFX, e *Env) {
+		fmt.Fprint(e, "other")
+	}}
+	return &stackingFX{Stacking: Stacking{
+		CC: []Componenter{hdr, other}}}, hdr, other
+}
+
+func (s *_hide) Hidden_component_takes_no_space(t *T) {
+	stk, hdr, other := hidingFX()
+	fx := fx(t, stk)
+	fx.FireResize(5, 2)
+	t.Eq("hider\nother", fx.Screen())
+	t.FatalOn(fx.Lines.Update(hdr, nil, func(e *Env) { hdr.Hide() }))
+	t.Eq("other\n     ", fx.Screen())
+	_, y, _, h := fx.Dim(other).Printable()
+	t.True(y == 0 && h == 2)
+	t.Eq(1, hdr.N(onHide))
+	t.FatalOn(fx.Lines.Update(hdr, nil, func(e *Env) {
+		t.True(hdr.IsHidden())
+		hdr.Show()
+	}))
+	t.Eq("hider\nother", fx.Screen())
+	t.Eq(1, hdr.N(onShow))
+}
+
+func (s *_hide) Hidden_component_keeps_its_state(t *T) {
+	stk, hdr, _ := hidingFX()
+	fx := fx(t, stk)
+	fx.FireResize(5, 2)
+	t.FatalOn(fx.Lines.Update(hdr, nil, func(e *Env) { hdr.Hide() }))
+	t.FatalOn(fx.Lines.Update(hdr, nil, func(e *Env) {
+		fmt.Fprint(e, "upd")
+	}))
+	t.FatalOn(fx.Lines.Update(hdr, nil, func(e *Env) { hdr.Show() }))
+	t.Eq("upd  \nother", fx.Screen())
+	t.Eq(1, hdr.N(onInit))
+}
+
+func (s *_hide) Hidden_component_gets_no_mouse_events(t *T) {
+	stk, hdr, other := hidingFX()
+	fx := fx(t, stk)
+	fx.FireResize(5, 2)
+	t.FatalOn(fx.Lines.Update(hdr, nil, func(e *Env) { hdr.Hide() }))
+	fx.FireClick(0, 0)
+	t.Eq(0, hdr.N(onMouseN))
+	t.True(other.N(onMouseN) > 0)
+}
+
+func (s *_hide) Moves_focus_of_hidden_component_to_its_container(
+	t *T,
+) {
+	stk, hdr, _ := hidingFX()
+	fx := fx(t, stk)
+	t.FatalOn(fx.Lines.Focus(hdr))
+	t.FatalOn(fx.Lines.Update(hdr, nil, func(e *Env) { hdr.Hide() }))
+	t.Eq(stk, fx.Lines.scr.focus.userComponent())
+	t.FatalOn(fx.Lines.Focus(hdr))
+	t.Eq(stk, fx.Lines.scr.focus.userComponent())
+}
+
+func (s *_hide) Forgets_removed_hidden_component_and_its_tasks(t *T) {
+	stk, hdr, _ := hidingFX()
+	fx := fx(t, stk)
+	t.FatalOn(fx.Lines.Update(hdr, nil, func(e *Env) { hdr.Hide() }))
+	var ctx context.Context
+	started := make(chan struct{})
+	fx.Lines.Go(hdr, func(c context.Context) (any, error) {
+		ctx = c
+		close(started)
+		<-c.Done()
+		return nil, c.Err()
+	}, nil)
+	<-started
+	t.FatalOn(fx.Lines.Update(stk, nil, func(*Env) {}))
+	t.FatalOn(ctx.Err())
+	t.FatalOn(fx.Lines.Update(stk, nil, func(*Env) {
+		stk.CC = stk.CC[1:]
+	}))
+	t.ErrIs(ctx.Err(), context.Canceled)
+	t.Eq(0, len(fx.Lines.scr.hidden))
+}
+
+func TestHide(t *testing.T) {
+	t.Parallel()
+	Run(&_hide{}, t)
+}
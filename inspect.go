@@ -0,0 +1,133 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package lines
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/slukits/lines/internal/lyt"
+)
+
+// DumpLayout writes the component tree of given Lines instance ll to
+// given writer w followed by the component trees of the layers in
+// their z-order.  Each component is written in its own line indented
+// by its depth in the tree.  The line provides the component's type,
+// its screen area, its printable area, its filling settings, its
+// margins and its clippings.  The layout is written by the event-loop
+// after all events posted before the DumpLayout call were processed
+// while DumpLayout blocks until it is written.  Hence DumpLayout must
+// not be called from within a listener callback.
+func (ll *Lines) DumpLayout(w io.Writer) error {
+	evt := &dumpEvent{when: time.Now(), w: w, done: make(chan struct{})}
+	if err := ll.backend.Post(evt); err != nil {
+		return err
+	}
+	select {
+	case <-evt.done:
+		return evt.err
+	case <-ll.quit:
+		return nil
+	}
+}
+
+// dumpEvent has the event-loop write the layout to its writer w.
+type dumpEvent struct {
+	when time.Time
+	w    io.Writer
+	err  error
+	done chan struct{}
+}
+
+func (e *dumpEvent) When() time.Time { return e.when }
+
+func (e *dumpEvent) Source() interface{} { return e }
+
+// dumpLabel labels given layout component d in a layout dump by the
+// type of its user component.
+func dumpLabel(d lyt.Dimer) string {
+	lc, ok := d.(layoutComponenter)
+	if !ok {
+		return fmt.Sprintf("%T", d)
+	}
+	return fmt.Sprintf("%T", lc.userComponent())
+}
+
+// Inspect turns the layout inspector on or off.  An inspecting Lines
+// instance outlines the component under the mouse and shows its layout
+// information as provided by [Lines.DumpLayout] in the last screen
+// line respectively in the first screen line if the mouse is in the
+// lower half of the screen.  Note while inspecting the screen is
+// redrawn after each event.
+func (ll *Lines) Inspect(on bool) error {
+	return ll.backend.Post(&inspectEvent{when: time.Now(), on: on})
+}
+
+type inspectEvent struct {
+	when time.Time
+	on   bool
+}
+
+func (e *inspectEvent) When() time.Time { return e.when }
+
+func (e *inspectEvent) Source() interface{} { return e }
+
+// inspector keeps track of the mouse position of an inspecting screen.
+type inspector struct{ x, y int }
+
+// track updates given inspector i's mouse position if given event evt
+// is a mouse event.
+func (i *inspector) track(evt interface{}) {
+	if i == nil {
+		return
+	}
+	if evt, ok := evt.(MouseEventer); ok {
+		i.x, i.y = evt.Pos()
+	}
+}
+
+// draw outlines the component of given screen s at given inspector i's
+// mouse position and displays its layout information.
+func (i *inspector) draw(s *screen) {
+	if i == nil {
+		return
+	}
+	path, err := s.lyt.LocateAt(i.x, i.y)
+	if err != nil || len(path) == 0 {
+		return
+	}
+	d := path[len(path)-1]
+	sty := s.backend.NewStyle().Reverse()
+	x, y, w, h := d.Dim().Screen()
+	if w > 0 && h > 0 {
+		right, bottom := x+w-1, y+h-1
+		for cx := x; cx <= right; cx++ {
+			s.backend.Display(cx, y, '─', sty)
+			s.backend.Display(cx, bottom, '─', sty)
+		}
+		for cy := y; cy <= bottom; cy++ {
+			s.backend.Display(x, cy, '│', sty)
+			s.backend.Display(right, cy, '│', sty)
+		}
+		s.backend.Display(x, y, '┌', sty)
+		s.backend.Display(right, y, '┐', sty)
+		s.backend.Display(x, bottom, '└', sty)
+		s.backend.Display(right, bottom, '┘', sty)
+	}
+	width, height := s.backend.Size()
+	line := height - 1
+	if i.y >= height/2 {
+		line = 0
+	}
+	rr := []rune(fmt.Sprintf("%s %s", dumpLabel(d), d.Dim()))
+	for cx := 0; cx < width; cx++ {
+		r := ' '
+		if cx < len(rr) {
+			r = rr[cx]
+		}
+		s.backend.Display(cx, line, r, sty)
+	}
+}
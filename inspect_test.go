@@ -0,0 +1,66 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package lines
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	. "github.com/slukits/gounit"
+)
+
+type _inspect struct{ Suite }
+
+func (s *_inspect) SetUp(t *T) { t.Parallel() }
+
+func (s *_inspect) Dumps_component_tree_with_its_layout(t *T) {
+	stk := &stackingFX{Stacking: Stacking{
+		CC: []Componenter{&cmpFX{}, &cmpFX{}}}}
+	fx, b := fx(t, stk), &bytes.Buffer{}
+	fx.FireResize(20, 4)
+	t.FatalOn(fx.DumpLayout(b))
+	ll := strings.Split(strings.TrimSpace(b.String()), "\n")
+	t.FatalIfNot(t.Eq(3, len(ll)))
+	t.True(strings.HasPrefix(ll[0], "*lines.stackingFX screen=0,0,20,4"))
+	t.True(strings.HasPrefix(ll[1], "  *lines.cmpFX screen=0,0,20,2"))
+	t.True(strings.HasPrefix(ll[2], "  *lines.cmpFX screen=0,2,20,2"))
+	t.Contains(ll[1], "filling=width+height")
+}
+
+func (s *_inspect) Dumps_layers_after_the_base_layout(t *T) {
+	lyr := &cmpFX{}
+	fx, b := fx(t, &cmpFX{onInit: func(c *cmpFX, e *Env) {
+		c.Layered(e, lyr, NewLayerPos(2, 1, 5, 2).SetZ(3))
+	}}), &bytes.Buffer{}
+	t.FatalOn(fx.DumpLayout(b))
+	t.Contains(b.String(), "layer 1 z=3\n  *lines.cmpFX screen=2,1,5,2")
+}
+
+func (s *_inspect) Outlines_component_under_the_mouse(t *T) {
+	stk := &stackingFX{Stacking: Stacking{
+		CC: []Componenter{&cmpFX{}, &cmpFX{}}}}
+	fx := fx(t, stk)
+	fx.FireResize(40, 8)
+	t.FatalOn(fx.Lines.Inspect(true))
+	fx.FireMove(5, 5)
+	scr := fx.Screen()
+	t.True(strings.HasPrefix(scr[4], "┌───"))
+	t.True(strings.HasPrefix(scr[7], "└───"))
+	t.True(strings.HasPrefix(scr[0], "*lines.cmpFX screen=0,4,40,4"))
+
+	fx.FireMove(5, 1)
+	scr = fx.Screen()
+	t.True(strings.HasPrefix(scr[0], "┌───"))
+	t.True(strings.HasPrefix(scr[7], "*lines.cmpFX screen=0,0,40,4"))
+
+	t.FatalOn(fx.Lines.Inspect(false))
+	t.Eq("", fx.Screen().Trimmed().String())
+}
+
+func TestInspect(t *testing.T) {
+	t.Parallel()
+	Run(&_inspect{}, t)
+}
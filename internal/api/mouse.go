@@ -108,3 +108,82 @@ func NewMouseDrop(to MouseEventer) *MouseDrop {
 		x: x, y: y, b: to.Button(), m: to.Mod(), when: to.When()},
 	}
 }
+
+// NewMouseAggregator returns a closure which combines the raw mouse
+// events it receives in reporting order to the aggregated mouse
+// events [MouseMove], [MouseClick], [MouseDrag] and [MouseDrop].  The
+// closure returns nil if a received event doesn't complete an
+// aggregation.
+func NewMouseAggregator() func(MouseEventer) MouseEventer {
+
+	var last MouseEventer
+	inDrag, ox, oy, firstMove := false, 0, 0, true
+
+	var clear = func(e MouseEventer) {
+		last = nil
+		ox, oy = e.Pos()
+		if inDrag {
+			inDrag = false
+		}
+	}
+
+	var eqPos = func(e, other MouseEventer) bool {
+		x, y := e.Pos()
+		ox, oy := other.Pos()
+		return x == ox && y == oy
+	}
+
+	var zeroEvt = func(e, other MouseEventer) bool {
+		return e.Button() == other.Button() && eqPos(e, other)
+	}
+
+	return func(e MouseEventer) (evt MouseEventer) {
+		switchFirstMove := func() {
+			if firstMove {
+				firstMove = false
+			}
+		}
+		switch last {
+		case nil:
+			if e.Button() == ZeroButton {
+				// ignore zero-button without movement
+				x, y := e.Pos()
+				if ox == x && oy == y && !firstMove {
+					return nil
+				}
+				switchFirstMove()
+				evt = NewMouseMove(ox, oy, e)
+				clear(e)
+				return evt
+			}
+			last = e
+			ox, oy = e.Pos()
+			switchFirstMove()
+			return nil
+		default:
+			switchFirstMove()
+			if zeroEvt(last, e) {
+				return nil
+			}
+			if last.Button() == e.Button() {
+				if !inDrag {
+					inDrag = true
+				}
+				last = e
+				return NewMouseDrag(ox, oy, e)
+			}
+			if inDrag {
+				inDrag = false
+				evt = NewMouseDrop(last)
+			} else {
+				evt = NewMouseClick(last)
+			}
+			if e.Button() == ZeroButton {
+				clear(e)
+				return evt
+			}
+			last = e
+			return evt
+		}
+	}
+}
@@ -142,8 +142,26 @@ func (ss StringScreen) forReverse(cb func(l liner) (stop bool)) {
 }
 
 type TestCell struct {
-	Rune  rune
-	Style Style
+	Rune rune
+	// Combining are the runes following Rune in a displayed grapheme
+	// cluster, e.g. an accent.
+	Combining []rune
+	Style     Style
+}
+
+// equals returns true if given test cells c and o display the same
+// runes in the same style.
+func (c TestCell) equals(o TestCell) bool {
+	if c.Style != o.Style || c.Rune != o.Rune ||
+		len(c.Combining) != len(o.Combining) {
+		return false
+	}
+	for i, r := range c.Combining {
+		if o.Combining[i] != r {
+			return false
+		}
+	}
+	return true
 }
 
 // CellsLine represents a line of a [CellsScreen] providing of each cell
@@ -187,6 +205,9 @@ func (l CellsLine) String() string {
 	b := strings.Builder{}
 	for _, c := range l {
 		b.WriteRune(c.Rune)
+		for _, r := range c.Combining {
+			b.WriteRune(r)
+		}
 	}
 	return b.String()
 }
@@ -321,7 +342,7 @@ func (cs CellsScreen) Equals(other CellsScreen) bool {
 				return false
 			}
 			oc := other[i][j]
-			if oc.Style != c.Style || oc.Rune != c.Rune {
+			if !oc.equals(c) {
 				return false
 			}
 		}
@@ -349,7 +370,7 @@ func (cs CellsScreen) FirstDiff(other CellsScreen) CellScreenDiff {
 				return d
 			}
 			oc := other[i][j]
-			if oc.Style != c.Style || oc.Rune != c.Rune {
+			if !oc.equals(c) {
 				d.Line, d.Cell = i, j
 				return d
 			}
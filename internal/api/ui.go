@@ -180,6 +180,31 @@ type EventProcessor interface {
 	OnQuit(listener func())
 }
 
+// Suspender is optionally implemented by an UIer whose screen can be
+// temporarily handed back to other programs, e.g. to run an external
+// editor in a terminal.
+type Suspender interface {
+
+	// Suspend stops input and output processing and restores the
+	// screen's state from before it was initialized.
+	Suspend() error
+
+	// Resume restores the state from before Suspend was called.  Note
+	// the screen content must be redrawn after resuming.
+	Resume() error
+}
+
+// ClusterDisplayer is optionally implemented by a Displayer which can
+// display a grapheme cluster, i.e. a rune followed by its combining
+// runes like an accent, in one screen cell.  Otherwise only the first
+// rune of a cluster is displayed.
+type ClusterDisplayer interface {
+
+	// DisplayCluster "writes" given grapheme cluster with given style
+	// at given coordinates to the screen.
+	DisplayCluster(int, int, []rune, Style)
+}
+
 // An UIer implementation provides the functionality lines needs to
 // provide its features.
 type UIer interface {
@@ -190,6 +215,18 @@ type UIer interface {
 	Lib() interface{}
 }
 
+// Backend is an UIer which reports its events to a listener which is
+// set after the Backend was created.
+type Backend interface {
+	UIer
+
+	// Listen sets the listener which is informed about all user input
+	// events and posted events.  An implementation must report a
+	// ResizeEventer with the current screen size after a listener was
+	// set.  Listen is a no-op if a listener was already set.
+	Listen(func(Eventer))
+}
+
 // Eventer is the abstract interface which must be implemented by all
 // reported/posted events.
 type Eventer interface {
@@ -236,6 +273,19 @@ type MouseEventer interface {
 	Pos() (int, int)
 }
 
+// BracketPasteEventer implementation is reported at the start and at
+// the end of a bracketed paste while the pasted content is reported in
+// between as rune and key events.
+type BracketPasteEventer interface {
+	Eventer
+
+	// Start returns true if the event marks the start of a paste.
+	Start() bool
+
+	// End returns true if the event marks the end of a paste.
+	End() bool
+}
+
 // ResizeEventer implementation is reported on a screen/window-size
 // change.
 type ResizeEventer interface {
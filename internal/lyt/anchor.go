@@ -0,0 +1,173 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package lyt
+
+// Placement defines at which side of its anchor an anchored layer is
+// placed, see [NewAnchoredLayerPos].
+type Placement int
+
+const (
+	// PlaceBelow places a layer below its anchor.
+	PlaceBelow Placement = iota
+
+	// PlaceAbove places a layer above its anchor.
+	PlaceAbove
+
+	// PlaceRight places a layer right of its anchor.
+	PlaceRight
+
+	// PlaceLeft places a layer left of its anchor.
+	PlaceLeft
+)
+
+// Alignment defines how an anchored layer is aligned along the side of
+// its anchor it is placed at.
+type Alignment int
+
+const (
+	// AlignStart aligns the left (respectively top) edges of a layer
+	// and its anchor.
+	AlignStart Alignment = iota
+
+	// AlignCenter centers a layer along its anchor.
+	AlignCenter
+
+	// AlignEnd aligns the right (respectively bottom) edges of a layer
+	// and its anchor.
+	AlignEnd
+)
+
+// layerAnchor is the reference of an anchored layer which is either the
+// screen area of a Dimer or a screen cell.
+type layerAnchor struct {
+	dimer     Dimer
+	x, y      int
+	placement Placement
+	alignment Alignment
+}
+
+// rect returns the screen area of an anchor.  The Dim of an anchoring
+// Dimer is obtained at the time the layer is laid out; is it nil the
+// anchor is accounted as being off-screen.
+func (a *layerAnchor) rect() (x, y, width, height int) {
+	if a.dimer == nil {
+		return a.x, a.y, 1, 1
+	}
+	if d := a.dimer.Dim(); d != nil {
+		return d.Screen()
+	}
+	return 0, 0, 0, 0
+}
+
+// NewAnchoredLayerPos creates a layer positioning of given width and
+// height which places a layer at given placement next to the screen
+// area of given anchor aligned according to given alignment.  The
+// anchor's Dim is obtained whenever the layer is laid out, i.e. it may
+// be nil at the time the layer positioning is created.  Is there not
+// enough space at given placement on the screen the layer is
+// flipped to the opposite side of its anchor; is there neither the
+// layer is shifted to stay on the screen.  An anchored layer follows
+// its anchor if the anchor's layout changes.  A none positive width or
+// height defaults to 30 respectively 8.
+func NewAnchoredLayerPos(
+	anchor Dimer, p Placement, a Alignment, width, height int,
+) *LayerPos {
+	pos := NewLayerPos(0, 0, width, height)
+	pos.anchor = &layerAnchor{dimer: anchor, placement: p, alignment: a}
+	return pos
+}
+
+// NewCellAnchoredLayerPos creates a layer positioning of given width
+// and height which places a layer at given placement next to the
+// screen cell with given coordinates x and y aligned according to
+// given alignment, see [NewAnchoredLayerPos].
+func NewCellAnchoredLayerPos(
+	x, y int, p Placement, a Alignment, width, height int,
+) *LayerPos {
+	pos := NewLayerPos(0, 0, width, height)
+	pos.anchor = &layerAnchor{x: x, y: y, placement: p, alignment: a}
+	return pos
+}
+
+// layoutAnchored lays out the root of an anchored layer next to its
+// anchor.
+func (l *Layer) layoutAnchored() {
+	a, sw, sh := l.Def.anchor, l.base.Width, l.base.Height
+	ax, ay, aw, ah := a.rect()
+	w, h := l.Def.width, l.Def.height
+	if w <= 0 {
+		w = 30
+	}
+	if h <= 0 {
+		h = 8
+	}
+	if w > sw {
+		w = sw
+	}
+	if h > sh {
+		h = sh
+	}
+	var x, y int
+	switch a.placement {
+	case PlaceBelow, PlaceAbove:
+		y = placed(a.placement == PlaceAbove, ay, ah, h, sh)
+		x = aligned(a.alignment, ax, aw, w, sw)
+	default:
+		x = placed(a.placement == PlaceLeft, ax, aw, w, sw)
+		y = aligned(a.alignment, ay, ah, h, sh)
+	}
+	d := l.Root.Dim()
+	if d.width > 0 && (d.x != x || d.y != y || d.width != w ||
+		d.height != h) && l.Def.movedFrom == nil {
+		l.Def.movedFrom = NewRect(d.x, d.y, d.width, d.height)
+	}
+	l.Def.x, l.Def.y = x, y
+	d.setOrigin(x, y)
+	d.SetWidth(w).SetHeight(h)
+}
+
+// placed returns the origin of a layer of given size placed before
+// respectively after an anchor at given position with given size
+// whereas the layer is flipped to the opposite side if it doesn't fit
+// on a screen of given screen size and shifted on the screen if it
+// fits on neither side.
+func placed(before bool, pos, size, layer, screen int) int {
+	roomBefore, roomAfter := pos, screen-pos-size
+	if before && roomBefore < layer && roomAfter > roomBefore {
+		before = false
+	}
+	if !before && roomAfter < layer && roomBefore > roomAfter {
+		before = true
+	}
+	if before {
+		return shifted(pos-layer, layer, screen)
+	}
+	return shifted(pos+size, layer, screen)
+}
+
+// aligned returns the origin of a layer of given size aligned with an
+// anchor at given position with given size according to given
+// alignment shifted to stay on a screen of given size.
+func aligned(a Alignment, pos, size, layer, screen int) int {
+	switch a {
+	case AlignCenter:
+		return shifted(pos+(size-layer)/2, layer, screen)
+	case AlignEnd:
+		return shifted(pos+size-layer, layer, screen)
+	}
+	return shifted(pos, layer, screen)
+}
+
+// shifted shifts given origin of a layer with given size such that the
+// layer stays on a screen of given size.
+func shifted(origin, layer, screen int) int {
+	if origin+layer > screen {
+		origin = screen - layer
+	}
+	if origin < 0 {
+		origin = 0
+	}
+	return origin
+}
@@ -0,0 +1,93 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package lyt
+
+import (
+	"testing"
+
+	. "github.com/slukits/gounit"
+)
+
+type anchor struct{ Suite }
+
+func (s *anchor) SetUp(t *T) { t.Parallel() }
+
+// layedOut returns a layer laid out on given base manager according to
+// given layer positioning.
+func layedOut(base *Manager, pos *LayerPos) *Layer {
+	l := &Layer{Dimer: df.New(), Def: pos}
+	l.Manager = &Manager{Root: l.Dimer, base: base}
+	l.layoutRoot()
+	return l
+}
+
+func (s *anchor) Places_layer_below_anchor(t *T) {
+	fx := sf.New(df.FixedH(5), df.FillingOne(), df.FixedH(3))
+	base := mf.ScreenOf(fx)
+	t.FatalOn(base.Reflow(nil))
+	l := layedOut(base, NewAnchoredLayerPos(
+		fx.dd[0], PlaceBelow, AlignStart, 20, 4))
+	x, y, w, h := l.Root.Dim().Screen()
+	t.True(x == 0 && y == 5 && w == 20 && h == 4)
+}
+
+func (s *anchor) Flips_layer_above_anchor_without_room_below(t *T) {
+	fx := sf.New(df.FixedH(5), df.FillingOne(), df.FixedH(3))
+	base := mf.ScreenOf(fx)
+	t.FatalOn(base.Reflow(nil))
+	l := layedOut(base, NewAnchoredLayerPos(
+		fx.dd[2], PlaceBelow, AlignStart, 20, 4))
+	_, y, _, h := l.Root.Dim().Screen()
+	t.True(y == 18 && h == 4)
+}
+
+func (s *anchor) Aligns_layer_along_its_anchor(t *T) {
+	fx := cf.New(df.FixedW(20), df.FixedW(20), df.FillingOne())
+	base := mf.ScreenOf(fx)
+	t.FatalOn(base.Reflow(nil))
+	l := layedOut(base, NewAnchoredLayerPos(
+		fx.dd[1], PlaceBelow, AlignCenter, 10, 4))
+	x, _, _, _ := l.Root.Dim().Screen()
+	t.Eq(25, x)
+	l = layedOut(base, NewAnchoredLayerPos(
+		fx.dd[1], PlaceBelow, AlignEnd, 10, 4))
+	x, _, _, _ = l.Root.Dim().Screen()
+	t.Eq(30, x)
+}
+
+func (s *anchor) Shifts_layer_to_stay_on_screen(t *T) {
+	base := mf.WHFilling(80, 25)
+	t.FatalOn(base.Reflow(nil))
+	l := layedOut(base, NewCellAnchoredLayerPos(
+		75, 3, PlaceBelow, AlignStart, 20, 4))
+	x, y, _, _ := l.Root.Dim().Screen()
+	t.True(x == 60 && y == 4)
+	l = layedOut(base, NewCellAnchoredLayerPos(
+		78, 3, PlaceRight, AlignStart, 20, 4))
+	x, y, _, _ = l.Root.Dim().Screen()
+	t.True(x == 58 && y == 3)
+}
+
+func (s *anchor) Follows_its_anchor_after_layout_change(t *T) {
+	fx := cf.New(df.FillingOne(), df.FillingOne())
+	base := mf.ScreenOf(fx)
+	t.FatalOn(base.Reflow(nil))
+	l := layedOut(base, NewAnchoredLayerPos(
+		fx.dd[1], PlaceBelow, AlignStart, 20, 4))
+	x, _, _, _ := l.Root.Dim().Screen()
+	t.Eq(40, x)
+	base.Width = 160
+	fx.Dim().SetWidth(160)
+	t.FatalOn(base.Reflow(nil))
+	l.layoutRoot()
+	x, _, _, _ = l.Root.Dim().Screen()
+	t.Eq(80, x)
+	t.True(l.Def.hasMoved())
+}
+
+func TestAnchor(t *testing.T) {
+	t.Parallel()
+	Run(&anchor{}, t)
+}
@@ -29,7 +29,7 @@ func layoutChainer(c Chainer) (err error) {
 		return nil
 	}
 	if filler > 0 {
-		layoutFilledChainer(c, minWidth, filler)
+		layoutFilledChainer(c, minWidth, n)
 		return nil
 	}
 	layoutFixedChainerUnderflowing(c, minWidth, n)
@@ -43,26 +43,39 @@ func layoutChainedOffScreen(c Chainer) {
 	})
 }
 
-func layoutFilledChainer(c Chainer, minWidth, filler int) {
+func layoutFilledChainer(c Chainer, minWidth, n int) {
 	x, y, chainerWidth, chainerHeight := area(c)
-	distribute := (chainerWidth - minWidth) / filler
-	distributeModulo := (chainerWidth - minWidth) % filler
-	shiftX := 0
+	ff := []filling{}
+	c.ForChained(func(d Dimer) (stop bool) {
+		if d.Dim().fillsWidth == 0 {
+			return false
+		}
+		weight, _ := d.Dim().Weight()
+		ff = append(ff, filling{weight: weight,
+			room: d.Dim().fillingRoomWidth()})
+		return false
+	})
+	extra, remaining := distributeFilling(ff, chainerWidth-minWidth)
+	var mm margins
+	if remaining > 0 {
+		mm = calculateMargins(remaining, n)
+	}
+	shiftX, i, j := 0, 0, 0
 	c.ForChained(func(d Dimer) (stop bool) {
 		d.Dim().setOrigin(x+shiftX, y)
 		d.Dim().setLayedOutHeight(chainerHeight, 0)
-		if d.Dim().fillsWidth == 0 { // fixed width Dimer
-			d.Dim().setLayedOutWidth(d.Dim().width, 0)
-			shiftX += d.Dim().layoutWidth()
-			return false
+		width := d.Dim().width
+		if d.Dim().fillsWidth > 0 {
+			width = d.Dim().fillsWidth + extra[j]
+			j++
 		}
-		fillerWidth := distribute + d.Dim().fillsWidth
-		if distributeModulo > 0 {
-			distributeModulo--
-			fillerWidth++
+		if mm == nil {
+			d.Dim().setLayedOutWidth(width, 0)
+		} else {
+			d.Dim().setLayedOutWidth(width+mm.sum(i), mm.right(i))
 		}
-		d.Dim().setLayedOutWidth(fillerWidth, 0)
 		shiftX += d.Dim().layoutWidth()
+		i++
 		return false
 	})
 }
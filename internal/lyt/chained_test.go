@@ -141,6 +141,49 @@ func (s *chained) With_width_filler_consume_all_width(t *T) {
 	}
 }
 
+func (s *chained) Width_filler_have_width_distributed_by_weights(
+	t *T,
+) {
+	// i.e. min-width 2 available 80 => 78 by 1:3: 19+1, 58 => 21, 59
+	fx := cf.New(df.FillingOne(), df.FillingOne())
+	fx.dd[1].Dim().SetWeight(3, 1)
+	t.FatalOn((&Manager{Root: fx}).Reflow(nil))
+	_, _, w0, _ := fx.dd[0].Dim().Printable()
+	_, _, w1, _ := fx.dd[1].Dim().Printable()
+	t.Eq(21, w0)
+	t.Eq(59, w1)
+	t.True(fx.HasConsistentLayout())
+}
+
+func (s *chained) Width_filler_do_not_exceed_their_maximum(t *T) {
+	fx := cf.New(df.FillingOne(), df.FillingOne(), df.FillingOne())
+	fx.dd[0].Dim().SetMaxWidth(20)
+	fx.dd[2].Dim().SetWeight(2, 1).SetMaxWidth(35)
+	t.FatalOn((&Manager{Root: fx}).Reflow(nil))
+	for i, exp := range []int{20, 25, 35} {
+		_, _, w, _ := fx.dd[i].Dim().Printable()
+		t.Eq(exp, w)
+	}
+	t.True(fx.HasConsistentLayout())
+}
+
+func (s *chained) With_maxed_out_width_filler_have_rl_margins(t *T) {
+	// min-width 21 available 80 => filler grows 9; 50 margins left
+	fx := cf.New(df.FillingOne(), df.Fixed())
+	fx.dd[0].Dim().SetMaxWidth(10)
+	t.FatalOn((&Manager{Root: fx}).Reflow(nil))
+	_, _, w, _ := fx.dd[0].Dim().Printable()
+	t.Eq(10, w)
+	exp := [][2]int{{16, 9}, {8, 17}}
+	for i, d := range fx.dd {
+		_, mr, _, ml := d.Dim().Margin()
+		t.Eq(exp[i][0], ml)
+		t.Eq(exp[i][1], mr)
+	}
+	t.Eq(fx.Width(), fx.SumLayoutWidths())
+	t.True(fx.HasConsistentLayout())
+}
+
 func (s *chained) With_width_filler_have_no_margins_at_fixed(t *T) {
 	fx := cf.New(df.Filling(), df.Fixed(), df.Filling(), df.Fixed(),
 		df.Filling())
@@ -29,6 +29,17 @@ type Dim struct {
 	// shrink further than its value.
 	fillsWidth, fillsHeight int
 
+	// weights determine the share of left over space a filling
+	// component receives in the respective dimension relative to the
+	// other filling components of its Stacker/Chainer.  A zero-value
+	// is interpreted as one while a negative value is interpreted as
+	// zero which may only be set by moving a split (see [MoveSplit]).
+	weightWidth, weightHeight int
+
+	// maximums limit the size a filling component can grow to in the
+	// respective dimension.  A zero-value means unlimited growing.
+	maxWidth, maxHeight int
+
 	// clipper indicate that a component has only a part of its needed
 	// area visible (width-clipWidth/height-clipHeight) on the screen.
 	// A component that can not be layouted on the screen has
@@ -203,6 +214,100 @@ func (d *Dim) SetFilling(width, height int) {
 	}
 }
 
+// SetWeight sets a filling Dimer's width and height weights which
+// determine its share of left over space relative to the weights of
+// the other filling Dimers of its Stacker respectively Chainer, e.g. a
+// chained sidebar with width weight 1 and an editor with width weight 3
+// have left over width distributed 1:3.  The default weight is one.  A
+// none positive weight is ignored.
+func (d *Dim) SetWeight(width, height int) *Dim {
+	if width > 0 && d.weightWidth != width {
+		d.weightWidth, d.isDirty = width, true
+	}
+	if height > 0 && d.weightHeight != height {
+		d.weightHeight, d.isDirty = height, true
+	}
+	return d
+}
+
+// Weight returns a Dimer's width and height weight, see
+// [Dim.SetWeight].  A weight is zero if a split was moved such that
+// the filling Dimer has its minimal size, see [MoveSplit].
+func (d *Dim) Weight() (width, height int) {
+	return weightOf(d.weightWidth), weightOf(d.weightHeight)
+}
+
+// weightOf maps a stored weight to its value.
+func weightOf(w int) int {
+	switch {
+	case w == 0:
+		return 1
+	case w < 0:
+		return 0
+	}
+	return w
+}
+
+// SetMaxWidth sets the maximum width a width filling Dimer can grow
+// to.  Is more width available it is filled with margins.  A maximum
+// smaller than the minimal filling width is interpreted as the minimal
+// filling width.  A none positive width removes the maximum.
+func (d *Dim) SetMaxWidth(w int) *Dim {
+	if w < 0 {
+		w = 0
+	}
+	if d.maxWidth != w {
+		d.maxWidth, d.isDirty = w, true
+	}
+	return d
+}
+
+// SetMaxHeight sets the maximum height a height filling Dimer can grow
+// to.  Is more height available it is filled with margins.  A maximum
+// smaller than the minimal filling height is interpreted as the
+// minimal filling height.  A none positive height removes the maximum.
+func (d *Dim) SetMaxHeight(h int) *Dim {
+	if h < 0 {
+		h = 0
+	}
+	if d.maxHeight != h {
+		d.maxHeight, d.isDirty = h, true
+	}
+	return d
+}
+
+// Max returns a Dimer's maximum width and height whereas zero means no
+// maximum is set, see [Dim.SetMaxWidth] and [Dim.SetMaxHeight].
+func (d *Dim) Max() (width, height int) {
+	return d.maxWidth, d.maxHeight
+}
+
+// fillingRoomWidth returns how much wider than its minimal filling
+// width a width filling Dimer can grow or -1 if it can grow
+// arbitrarily.
+func (d *Dim) fillingRoomWidth() int {
+	if d.maxWidth == 0 {
+		return -1
+	}
+	if d.maxWidth < d.fillsWidth {
+		return 0
+	}
+	return d.maxWidth - d.fillsWidth
+}
+
+// fillingRoomHeight returns how much higher than its minimal filling
+// height a height filling Dimer can grow or -1 if it can grow
+// arbitrarily.
+func (d *Dim) fillingRoomHeight() int {
+	if d.maxHeight == 0 {
+		return -1
+	}
+	if d.maxHeight < d.fillsHeight {
+		return 0
+	}
+	return d.maxHeight - d.fillsHeight
+}
+
 // IsUpdated returns true after UpdateWidth or UpdateHeight was used and
 // the layout has not been recalculated yet.
 func (d *Dim) IsUpdated() bool {
@@ -395,9 +500,15 @@ func (d *Dim) setLayedOutWidth(w, mrgRight int) {
 		if d.fillsWidth > w {
 			d.clipWidth = d.fillsWidth - w
 			d.width = d.fillsWidth
-		} else {
+			return
+		}
+		room := d.fillingRoomWidth()
+		if room < 0 || d.fillsWidth+room >= w {
 			d.width = w
+			return
 		}
+		d.width = d.fillsWidth + room
+		d.setHorizontalMargins(w, mrgRight)
 		return
 	}
 	if d.width > w {
@@ -405,6 +516,12 @@ func (d *Dim) setLayedOutWidth(w, mrgRight int) {
 		return
 	}
 	// hence d.width < w
+	d.setHorizontalMargins(w, mrgRight)
+}
+
+// setHorizontalMargins fills given width w which is wider than d's
+// width with margins.
+func (d *Dim) setHorizontalMargins(w, mrgRight int) {
 	if mrgRight > 0 {
 		d.mrgRight = mrgRight
 		d.mrgLeft = w - d.width - mrgRight
@@ -432,9 +549,15 @@ func (d *Dim) setLayedOutHeight(h int, mrgBottom int) {
 		if d.fillsHeight > h {
 			d.clipHeight = d.fillsHeight - h
 			d.height = d.fillsHeight
-		} else {
+			return
+		}
+		room := d.fillingRoomHeight()
+		if room < 0 || d.fillsHeight+room >= h {
 			d.height = h
+			return
 		}
+		d.height = d.fillsHeight + room
+		d.setVerticalMargins(h, mrgBottom)
 		return
 	}
 	if d.height > h {
@@ -442,6 +565,12 @@ func (d *Dim) setLayedOutHeight(h int, mrgBottom int) {
 		return
 	}
 	// hence d.height < h
+	d.setVerticalMargins(h, mrgBottom)
+}
+
+// setVerticalMargins fills given height h which is higher than d's
+// height with margins.
+func (d *Dim) setVerticalMargins(h, mrgBottom int) {
 	if mrgBottom > 0 {
 		d.mrgBottom = mrgBottom
 		d.mrgTop = h - d.height - mrgBottom
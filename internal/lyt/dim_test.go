@@ -81,6 +81,27 @@ func (s *_Dim) Is_not_dirty_after_being_cleaned(t *T) {
 	t.Not.True(fx.IsDirty())
 }
 
+func (s *_Dim) Has_weight_one_by_default(t *T) {
+	fx := DimFilling(1, 1)
+	w, h := fx.Weight()
+	t.True(w == 1 && h == 1)
+	fx.SetWeight(0, -1)
+	w, h = fx.Weight()
+	t.True(w == 1 && h == 1)
+	fx.SetWeight(2, 3)
+	w, h = fx.Weight()
+	t.True(w == 2 && h == 3)
+}
+
+func (s *_Dim) Removes_maximum_given_non_positive_maximum(t *T) {
+	fx := DimFilling(1, 1).SetMaxWidth(10).SetMaxHeight(5)
+	w, h := fx.Max()
+	t.True(w == 10 && h == 5)
+	fx.SetMaxWidth(0).SetMaxHeight(-1)
+	w, h = fx.Max()
+	t.True(w == 0 && h == 0)
+}
+
 func TestDim(t *testing.T) {
 	t.Parallel()
 	Run(&_Dim{}, t)
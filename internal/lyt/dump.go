@@ -0,0 +1,103 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package lyt
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// String returns the layout information of a Dim, i.e. its screen
+// area, printable area, filling settings, margins and clippings.
+func (d *Dim) String() string {
+	x, y, w, h := d.Screen()
+	px, py, pw, ph := d.Printable()
+	filling := []string{}
+	if d.IsFillingWidth() {
+		filling = append(filling, "width")
+	}
+	if d.IsFillingHeight() {
+		filling = append(filling, "height")
+	}
+	if len(filling) == 0 {
+		filling = append(filling, "none")
+	}
+	mw, mh := d.Max()
+	ww, wh := d.Weight()
+	mt, mr, mb, ml := d.Margin()
+	cw, ch := d.Clip()
+	s := fmt.Sprintf("screen=%d,%d,%d,%d printable=%d,%d,%d,%d "+
+		"filling=%s max=%d,%d weight=%d,%d margin=%d,%d,%d,%d clip=%d,%d",
+		x, y, w, h, px, py, pw, ph, strings.Join(filling, "+"),
+		mw, mh, ww, wh, mt, mr, mb, ml, cw, ch)
+	if d.IsOffScreen() {
+		s += " off-screen"
+	}
+	return s
+}
+
+// Dump writes the Dimer tree of given Manager m to given writer w
+// followed by the Dimer trees of its layers in their z-order.  Each
+// Dimer is written in its own line indented by its depth in the tree
+// and labeled by given label function which defaults to the Dimer's
+// type.  The label is followed by the Dimer's layout information, see
+// [Dim.String].  Note m's layers are only known after m's first
+// Reflow.
+func (m *Manager) Dump(w io.Writer, label func(Dimer) string) error {
+	if label == nil {
+		label = func(d Dimer) string { return fmt.Sprintf("%T", d) }
+	}
+	if m.Root != nil {
+		if err := dumpDimer(w, m.Root, 0, label); err != nil {
+			return err
+		}
+	}
+	var err error
+	i := 0
+	m.Layers.For(func(l *Layer) (stop bool) {
+		i++
+		if _, err = fmt.Fprintf(
+			w, "layer %d z=%d\n", i, l.Def.Z()); err != nil {
+			return true
+		}
+		err = dumpDimer(w, l.Root, 1, label)
+		return err != nil
+	})
+	return err
+}
+
+func dumpDimer(
+	w io.Writer, d Dimer, depth int, label func(Dimer) string,
+) error {
+	if _, err := fmt.Fprintf(w, "%s%s %s\n",
+		strings.Repeat("  ", depth), label(d), d.Dim()); err != nil {
+		return err
+	}
+	var forDD func(func(Dimer) (stop bool))
+	switch d := d.(type) {
+	case Stacker:
+		forDD = d.ForStacked
+	case Chainer:
+		forDD = d.ForChained
+	case Grider:
+		forDD = forGridded(d)
+	case Placer:
+		forDD = forPlaced(d)
+	default:
+		return nil
+	}
+	dd := []Dimer{}
+	forDD(func(d Dimer) (stop bool) {
+		dd = append(dd, d)
+		return false
+	})
+	for _, d := range dd {
+		if err := dumpDimer(w, d, depth+1, label); err != nil {
+			return err
+		}
+	}
+	return nil
+}
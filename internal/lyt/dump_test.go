@@ -0,0 +1,64 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package lyt
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	. "github.com/slukits/gounit"
+)
+
+type dumping struct{ Suite }
+
+func (s *dumping) SetUp(t *T) { t.Parallel() }
+
+func (s *dumping) Provides_dim_s_layout_information(t *T) {
+	fx := &stackerFX{Dimer: df.Screen(), dd: []Dimer{
+		df.FillingFixed(1, 5), df.Filling()}}
+	t.FatalOn(mf.ScreenOf(fx).Reflow(nil))
+	t.Eq("screen=0,0,80,5 printable=0,0,80,5 filling=width max=0,0 "+
+		"weight=1,1 margin=0,0,0,0 clip=0,0", fx.dd[0].Dim().String())
+	fx.dd[0].Dim().setOffScreen()
+	t.Contains(fx.dd[0].Dim().String(), "off-screen")
+}
+
+func (s *dumping) Writes_indented_dimer_tree(t *T) {
+	nested := &stackerFX{Dimer: df.Filling(), dd: []Dimer{df.Filling()}}
+	fx := &stackerFX{Dimer: df.Screen(), dd: []Dimer{df.FillingFixed(1, 5), nested}}
+	m, b := mf.ScreenOf(fx), &bytes.Buffer{}
+	t.FatalOn(m.Reflow(nil))
+	t.FatalOn(m.Dump(b, nil))
+	ll := strings.Split(strings.TrimSpace(b.String()), "\n")
+	t.FatalIfNot(t.Eq(4, len(ll)))
+	t.True(strings.HasPrefix(ll[0], "*lyt.stackerFX screen=0,0,80,25"))
+	t.True(strings.HasPrefix(ll[1], "  *lyt.dimerFixture screen=0,0,80,5"))
+	t.True(strings.HasPrefix(ll[2], "  *lyt.stackerFX screen=0,5,80,20"))
+	t.True(strings.HasPrefix(ll[3],
+		"    *lyt.dimerFixture screen=0,5,80,20"))
+}
+
+func (s *dumping) Writes_layers_in_their_z_order(t *T) {
+	m, ll := overlapping(2)
+	ll[0].Def.SetZ(1)
+	b := &bytes.Buffer{}
+	t.FatalOn(m.Reflow(nil))
+	t.FatalOn(m.Dump(b, func(d Dimer) string {
+		for i, l := range ll {
+			if l.Dimer == d {
+				return []string{"first", "second"}[i]
+			}
+		}
+		return "base"
+	}))
+	t.Contains(b.String(), "layer 1 z=0\n  second screen=5,5,10,5")
+	t.Contains(b.String(), "layer 2 z=1\n  first screen=5,5,10,5")
+}
+
+func TestDumping(t *testing.T) {
+	t.Parallel()
+	Run(&dumping{}, t)
+}
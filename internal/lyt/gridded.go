@@ -0,0 +1,228 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package lyt
+
+import "fmt"
+
+// Track describes the size of a column or row of a Grider's grid.  A
+// track with zero Weight has the fixed size Size.  A track with
+// positive Weight is filling, i.e. it can't shrink below Size and it
+// receives a share of the left over space of its grid which is
+// proportional to its Weight.
+type Track struct {
+	Size, Weight int
+}
+
+// TrackFixed returns a track with given fixed size.
+func TrackFixed(size int) Track { return Track{Size: size} }
+
+// TrackFilling returns a filling track with given minimal size and
+// weight one.
+func TrackFilling(min int) Track { return Track{Size: min, Weight: 1} }
+
+// TrackFraction returns a filling track with given minimal size and
+// given weight.  E.g. two columns with the weights 1 and 3 have the
+// left over width of their grid distributed 1:3.
+func TrackFraction(min, weight int) Track {
+	return Track{Size: min, Weight: weight}
+}
+
+// Cell locates a Dimer in a grid.  Column and Row are zero based
+// indices of the grid's tracks; ColumnSpan and RowSpan are the number
+// of tracks a Dimer spans whereas zero is interpreted as one.
+type Cell struct {
+	Column, Row, ColumnSpan, RowSpan int
+}
+
+func (c Cell) spans() (columns, rows int) {
+	columns, rows = c.ColumnSpan, c.RowSpan
+	if columns == 0 {
+		columns = 1
+	}
+	if rows == 0 {
+		rows = 1
+	}
+	return columns, rows
+}
+
+// Grider is implemented by components who consist of Dimers which are
+// arranged in the cells of a grid whose columns and rows are aligned
+// across all its Dimers.
+type Grider interface {
+	Dimer
+
+	// Tracks provides the grid's column and row tracks.
+	Tracks() (columns, rows []Track)
+
+	// ForGridded provides the gridded Dimers together with the cell
+	// they are placed in.
+	ForGridded(func(Dimer, Cell) (stop bool))
+}
+
+// forGridded adapts given Grider g's ForGridded to the callback
+// signature of ForStacked and ForChained.
+func forGridded(g Grider) func(func(Dimer) (stop bool)) {
+	return func(cb func(Dimer) (stop bool)) {
+		g.ForGridded(func(d Dimer, _ Cell) (stop bool) { return cb(d) })
+	}
+}
+
+func layoutGrider(g Grider) error {
+	columns, rows := g.Tracks()
+	if err := validateTracks(columns); err != nil {
+		return err
+	}
+	if err := validateTracks(rows); err != nil {
+		return err
+	}
+	x, y, griderWidth, griderHeight := area(g)
+	if griderWidth <= 0 || griderHeight <= 0 ||
+		len(columns) == 0 || len(rows) == 0 {
+		layoutGriddedOffScreen(g)
+		return nil
+	}
+	ww, shiftX := trackSizes(columns, griderWidth)
+	hh, shiftY := trackSizes(rows, griderHeight)
+	xx, yy := trackOrigins(ww, x+shiftX), trackOrigins(hh, y+shiftY)
+	var err error
+	g.ForGridded(func(d Dimer, c Cell) (stop bool) {
+		cs, rs := c.spans()
+		if c.Column < 0 || c.Row < 0 || cs < 0 || rs < 0 {
+			err = fmt.Errorf("%w%s", ErrDim,
+				"grid-layout: cell must not be negative")
+			return true
+		}
+		width, height := spanSize(ww, c.Column, cs), spanSize(hh, c.Row, rs)
+		if width <= 0 || height <= 0 {
+			d.Dim().setOffScreen()
+			return false
+		}
+		d.Dim().setOrigin(xx[c.Column], yy[c.Row])
+		d.Dim().setLayedOutWidth(width, 0)
+		d.Dim().setLayedOutHeight(height, 0)
+		return false
+	})
+	return err
+}
+
+func layoutGriddedOffScreen(g Grider) {
+	g.ForGridded(func(d Dimer, _ Cell) (stop bool) {
+		d.Dim().setOffScreen()
+		return false
+	})
+}
+
+// validateTracks checks that given tracks have no negative values and
+// that fixed tracks have a size.
+func validateTracks(tt []Track) error {
+	for _, t := range tt {
+		if t.Size < 0 || t.Weight < 0 {
+			return fmt.Errorf("%w%s", ErrDim,
+				"grid-layout: track must not be negative")
+		}
+		if t.Weight == 0 && t.Size == 0 {
+			return fmt.Errorf("%w%s", ErrDim,
+				"grid-layout: track must be filling or have size")
+		}
+	}
+	return nil
+}
+
+// trackSizes calculates the sizes of given tracks tt for given
+// available space.  Overflowing tracks get their (minimal) size until
+// the available space is exhausted, i.e. the last tracks may be clipped
+// or have zero size.  Left over space is distributed amongst filling
+// tracks according to their weights.  Is there no filling track the
+// left over space is evenly distributed before and after the tracks
+// whereas returned shift is the space before the tracks.
+func trackSizes(tt []Track, available int) (sizes []int, shift int) {
+	min, weight, sizes := 0, 0, make([]int, len(tt))
+	for _, t := range tt {
+		min += t.Size
+		weight += t.Weight
+	}
+	if min > available {
+		for i, t := range tt {
+			sizes[i] = t.Size
+			if sizes[i] > available {
+				sizes[i] = available
+			}
+			available -= sizes[i]
+		}
+		return sizes, 0
+	}
+	distribute, distributed := available-min, 0
+	for i, t := range tt {
+		sizes[i] = t.Size
+		if weight == 0 {
+			continue
+		}
+		sizes[i] += distribute * t.Weight / weight
+		distributed += distribute * t.Weight / weight
+	}
+	if weight == 0 {
+		return sizes, distribute / 2
+	}
+	modulo := distribute - distributed
+	for i, t := range tt {
+		if modulo == 0 {
+			break
+		}
+		if t.Weight == 0 {
+			continue
+		}
+		sizes[i]++
+		modulo--
+	}
+	return sizes, 0
+}
+
+// trackOrigins returns the origins of tracks with given sizes starting
+// at given origin o.
+func trackOrigins(sizes []int, o int) []int {
+	oo := make([]int, len(sizes))
+	for i, s := range sizes {
+		oo[i] = o
+		o += s
+	}
+	return oo
+}
+
+// spanSize returns the sum of given n sizes starting at given index
+// idx; sizes beyond the last track are zero.
+func spanSize(sizes []int, idx, n int) (sum int) {
+	for i := idx; i < idx+n && i < len(sizes); i++ {
+		sum += sizes[i]
+	}
+	return sum
+}
+
+func isConsistentGrider(g Grider) bool {
+	columns, rows := g.Tracks()
+	x, y, griderWidth, griderHeight := area(g)
+	if griderWidth <= 0 || griderHeight <= 0 ||
+		len(columns) == 0 || len(rows) == 0 {
+		return true
+	}
+	ww, shiftX := trackSizes(columns, griderWidth)
+	hh, shiftY := trackSizes(rows, griderHeight)
+	xx, yy := trackOrigins(ww, x+shiftX), trackOrigins(hh, y+shiftY)
+	ok := true
+	g.ForGridded(func(d Dimer, c Cell) (stop bool) {
+		if d.Dim().IsOffScreen() {
+			return false
+		}
+		cs, rs := c.spans()
+		dx, dy, _, _ := d.Dim().Screen()
+		if dx != xx[c.Column] || dy != yy[c.Row] ||
+			d.Dim().layoutWidth() != spanSize(ww, c.Column, cs) ||
+			d.Dim().layoutHeight() != spanSize(hh, c.Row, rs) {
+			ok = false
+			return true
+		}
+		return false
+	})
+	return ok
+}
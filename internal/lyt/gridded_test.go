@@ -0,0 +1,218 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package lyt
+
+import (
+	"testing"
+
+	. "github.com/slukits/gounit"
+	"github.com/slukits/lines/internal/api"
+)
+
+type griderFX struct {
+	Dimer
+	columns, rows []Track
+	dd            []Dimer
+	cc            []Cell
+	gg            api.Gaps
+}
+
+func (gf *griderFX) Tracks() (columns, rows []Track) {
+	return gf.columns, gf.rows
+}
+
+func (gf *griderFX) ForGridded(cb func(Dimer, Cell) (stop bool)) {
+	for i, d := range gf.dd {
+		if cb(d, gf.cc[i]) {
+			return
+		}
+	}
+}
+
+func (gf *griderFX) Gaps() api.Gaps { return gf.gg }
+
+// Place adds given Dimer d at given cell c.
+func (gf *griderFX) Place(d Dimer, c Cell) *griderFX {
+	gf.dd, gf.cc = append(gf.dd, d), append(gf.cc, c)
+	return gf
+}
+
+type griderFactory struct{}
+
+var gf = &griderFactory{}
+
+// New produces a new Grider-implementation instance with default
+// screen dimensions and given columns and rows.
+func (gf *griderFactory) New(columns, rows []Track) *griderFX {
+	return &griderFX{Dimer: df.Screen(), columns: columns, rows: rows}
+}
+
+// Of produces a new Grider-implementation instance wrapping given
+// Dimer having given columns and rows.
+func (gf *griderFactory) Of(d Dimer, columns, rows []Track) *griderFX {
+	return &griderFX{Dimer: d, columns: columns, rows: rows}
+}
+
+func tt(tt ...Track) []Track { return tt }
+
+type gridded struct{ Suite }
+
+func (s *gridded) SetUp(t *T) { t.Parallel() }
+
+func (s *gridded) Fails_if_fixed_track_has_zero_size(t *T) {
+	fx := gf.New(tt(TrackFixed(0)), tt(TrackFilling(1))).
+		Place(df.FillingOne(), Cell{})
+	t.ErrIs((&Manager{Root: fx}).Reflow(nil), ErrDim)
+	fx = gf.New(tt(TrackFilling(1)), tt(TrackFixed(-1))).
+		Place(df.FillingOne(), Cell{})
+	t.ErrIs((&Manager{Root: fx}).Reflow(nil), ErrDim)
+}
+
+func (s *gridded) Fails_if_cell_is_negative(t *T) {
+	fx := gf.New(tt(TrackFilling(1)), tt(TrackFilling(1))).
+		Place(df.FillingOne(), Cell{Column: -1})
+	t.ErrIs((&Manager{Root: fx}).Reflow(nil), ErrDim)
+}
+
+func (s *gridded) Aligns_columns_across_rows(t *T) {
+	fx := gf.New(
+		tt(TrackFixed(10), TrackFilling(1), TrackFixed(20)),
+		tt(TrackFilling(1), TrackFilling(1)),
+	)
+	for r := 0; r < 2; r++ {
+		for c := 0; c < 3; c++ {
+			fx.Place(df.FillingOne(), Cell{Column: c, Row: r})
+		}
+	}
+	t.FatalOn((&Manager{Root: fx}).Reflow(nil))
+	xx, ww := []int{0, 10, 60}, []int{10, 50, 20}
+	for i, d := range fx.dd {
+		x, y, w, h := d.Dim().Printable()
+		t.Eq(xx[i%3], x)
+		t.Eq(ww[i%3], w)
+		t.Eq((i/3)*13, y)
+		t.Eq(13-i/3, h)
+	}
+	t.True((&Manager{Root: fx}).HasConsistentLayout())
+}
+
+func (s *gridded) Distributes_left_over_space_by_weights(t *T) {
+	fx := gf.New(
+		tt(TrackFraction(0, 1), TrackFraction(0, 3)),
+		tt(TrackFilling(1)),
+	).Place(df.FillingOne(), Cell{}).Place(df.FillingOne(), Cell{Column: 1})
+	t.FatalOn((&Manager{Root: fx}).Reflow(nil))
+	_, _, w0, _ := fx.dd[0].Dim().Printable()
+	_, _, w1, _ := fx.dd[1].Dim().Printable()
+	t.Eq(20, w0)
+	t.Eq(60, w1)
+}
+
+func (s *gridded) Respects_minimum_size_of_filling_tracks(t *T) {
+	fx := gf.New(
+		tt(TrackFraction(30, 1), TrackFraction(0, 1)),
+		tt(TrackFilling(1)),
+	).Place(df.FillingOne(), Cell{}).Place(df.FillingOne(), Cell{Column: 1})
+	t.FatalOn((&Manager{Root: fx}).Reflow(nil))
+	_, _, w0, _ := fx.dd[0].Dim().Printable()
+	_, _, w1, _ := fx.dd[1].Dim().Printable()
+	t.Eq(55, w0)
+	t.Eq(25, w1)
+}
+
+func (s *gridded) Spans_cells_over_multiple_tracks(t *T) {
+	fx := gf.New(
+		tt(TrackFixed(10), TrackFixed(20), TrackFilling(1)),
+		tt(TrackFixed(5), TrackFilling(1)),
+	).Place(df.FillingOne(), Cell{ColumnSpan: 2}).
+		Place(df.FillingOne(), Cell{Column: 2, RowSpan: 2}).
+		Place(df.FillingOne(), Cell{Row: 1, ColumnSpan: 2})
+	t.FatalOn((&Manager{Root: fx}).Reflow(nil))
+	x, y, w, h := fx.dd[0].Dim().Printable()
+	t.True(x == 0 && y == 0 && w == 30 && h == 5)
+	x, y, w, h = fx.dd[1].Dim().Printable()
+	t.True(x == 30 && y == 0 && w == 50 && h == 25)
+	x, y, w, h = fx.dd[2].Dim().Printable()
+	t.True(x == 0 && y == 5 && w == 30 && h == 20)
+	t.True((&Manager{Root: fx}).HasConsistentLayout())
+}
+
+func (s *gridded) Centers_fixed_tracks_in_underflowing_grid(t *T) {
+	fx := gf.New(tt(TrackFixed(20)), tt(TrackFixed(5))).
+		Place(df.FillingOne(), Cell{})
+	t.FatalOn((&Manager{Root: fx}).Reflow(nil))
+	x, y, w, h := fx.dd[0].Dim().Printable()
+	t.True(x == 30 && y == 10 && w == 20 && h == 5)
+}
+
+func (s *gridded) Adds_margins_to_fixed_dimer_in_larger_cell(t *T) {
+	fx := gf.New(tt(TrackFilling(1)), tt(TrackFilling(1))).
+		Place(df.FixedWH(20, 5), Cell{})
+	t.FatalOn((&Manager{Root: fx}).Reflow(nil))
+	mt, mr, mb, ml := fx.dd[0].Dim().Margin()
+	t.True(mt == 10 && mb == 10 && ml == 30 && mr == 30)
+	t.True((&Manager{Root: fx}).HasConsistentLayout())
+}
+
+func (s *gridded) Clips_overflowing_tracks_and_flags_off_screen(t *T) {
+	fx := gf.New(
+		tt(TrackFixed(50), TrackFixed(40), TrackFilling(10)),
+		tt(TrackFilling(1)),
+	).Place(df.FillingOne(), Cell{}).
+		Place(df.FixedWH(40, 5), Cell{Column: 1}).
+		Place(df.FillingOne(), Cell{Column: 2})
+	t.FatalOn((&Manager{Root: fx}).Reflow(nil))
+	_, _, w, _ := fx.dd[0].Dim().Printable()
+	t.Eq(50, w)
+	clipped, _ := fx.dd[1].Dim().Clip()
+	t.Eq(10, clipped)
+	t.True(fx.dd[2].Dim().IsOffScreen())
+}
+
+func (s *gridded) Flags_cells_outside_its_tracks_off_screen(t *T) {
+	fx := gf.New(tt(TrackFilling(1)), tt(TrackFilling(1))).
+		Place(df.FillingOne(), Cell{}).
+		Place(df.FillingOne(), Cell{Column: 1})
+	t.FatalOn((&Manager{Root: fx}).Reflow(nil))
+	t.Not.True(fx.dd[0].Dim().IsOffScreen())
+	t.True(fx.dd[1].Dim().IsOffScreen())
+}
+
+func (s *gridded) Nests_dimmer_accounting_for_grider_s_gaps(t *T) {
+	fx := gf.Of(df.FixedWH(20, 20),
+		tt(TrackFilling(1), TrackFilling(1)), tt(TrackFilling(1)))
+	fx.gg = api.Gaps{Top: 2, Right: 2, Bottom: 2, Left: 2}
+	fx.Place(df.FillingOne(), Cell{}).Place(df.FillingOne(), Cell{Column: 1})
+	t.FatalOn((&Manager{Root: fx}).Reflow(nil))
+	x, y, w, h := fx.dd[0].Dim().Screen()
+	t.True(x == 2 && y == 2 && w == 8 && h == 16)
+	x, y, w, h = fx.dd[1].Dim().Screen()
+	t.True(x == 10 && y == 2 && w == 8 && h == 16)
+}
+
+func (s *gridded) Layouts_nested_containers(t *T) {
+	nested := sf.Filling(df.Filling(), df.Filling())
+	fx := gf.New(tt(TrackFilling(1), TrackFilling(1)), tt(TrackFilling(1))).
+		Place(df.FillingOne(), Cell{}).Place(nested, Cell{Column: 1})
+	m := &Manager{Root: fx}
+	t.FatalOn(m.Reflow(nil))
+	t.True(nested.HasConsistentLayout())
+	x, _, w, _ := nested.dd[0].Dim().Printable()
+	t.True(x == 40 && w == 40)
+	path, err := m.Locate(nested.dd[1])
+	t.FatalOn(err)
+	t.FatalIfNot(t.Eq(2, len(path)))
+	t.True(path[0] == fx && path[1] == nested)
+	_, y, _, _ := nested.dd[1].Dim().Printable()
+	path, err = m.LocateAt(41, y)
+	t.FatalOn(err)
+	t.FatalIfNot(t.Eq(3, len(path)))
+	t.True(path[2] == nested.dd[1])
+}
+
+func TestGridded(t *testing.T) {
+	t.Parallel()
+	Run(&gridded{}, t)
+}
@@ -27,6 +27,7 @@ type LayerPos struct {
 	z                   int
 	movedFrom           *Rect
 	isDirty             bool
+	anchor              *layerAnchor
 }
 
 func NewLayerPos(x, y, width, height int) *LayerPos {
@@ -38,6 +39,9 @@ func (p *LayerPos) Y() int      { return p.y }
 func (p *LayerPos) Width() int  { return p.width }
 func (p *LayerPos) Height() int { return p.height }
 
+// Z returns a layer's z-level, see [LayerPos.SetZ].
+func (p *LayerPos) Z() int { return p.z }
+
 func (p *LayerPos) hasMoved() bool {
 	if p == nil {
 		return false
@@ -63,6 +67,9 @@ func (p *LayerPos) isZero() bool {
 	if p == nil {
 		return true
 	}
+	if p.anchor != nil {
+		return false
+	}
 	return p.x == 0 && p.y == 0 && p.width == 0 && p.height == 0
 }
 
@@ -153,8 +160,10 @@ func (ll *Layers) For(cb func(*Layer) (stop bool)) {
 	}
 }
 
+// sort orders given layers ll by their z-level whereas layers of the
+// same z-level keep their order of appearance.
 func (ll *Layers) sort() *Layers {
-	sort.Slice(ll.oo, func(i, j int) bool {
+	sort.SliceStable(ll.oo, func(i, j int) bool {
 		return ll.ll[ll.oo[i]].Def.z < ll.ll[ll.oo[j]].Def.z
 	})
 	return ll
@@ -165,28 +174,95 @@ func (ll *Layers) ForReversed(cb func(*Layer) (stop bool)) {
 		return
 	}
 	for i := len(ll.oo) - 1; i >= 0; i-- {
-		cb(ll.ll[ll.oo[i]])
+		if cb(ll.ll[ll.oo[i]]) {
+			return
+		}
 	}
 }
 
 // Encloses returns the top-most layer containing given position
-// with the coordinates x and y.  Encloses returns nil if there is
-// not layer containing (x,y).
+// with the coordinates x and y, i.e. the containing layer with the
+// highest z-level respectively the last appearing of those with the
+// highest z-level.  Encloses returns nil if there is not layer
+// containing (x,y).
 func (ll *Layers) Encloses(x, y int) *Layer {
 	if ll == nil {
 		return nil
 	}
 	var lyr *Layer
-	ll.ForReversed(func(l *Layer) (stop bool) {
+	ll.For(func(l *Layer) (stop bool) {
 		if !l.Root.Dim().Contains(x, y) {
 			return
 		}
-		lyr = l
-		return true
+		if lyr == nil || lyr.Def.z <= l.Def.z {
+			lyr = l
+		}
+		return
 	})
 	return lyr
 }
 
+// Raise puts given layer l on top of all other layers of given layers
+// ll by giving it a z-level higher than any other layer's z-level.
+func (ll *Layers) Raise(l *Layer) {
+	if ll == nil || l == nil {
+		return
+	}
+	top, isTop := l.Def.z, true
+	ll.For(func(o *Layer) (stop bool) {
+		if o == l || o.Def.z < top {
+			return
+		}
+		top, isTop = o.Def.z, false
+		return
+	})
+	if isTop {
+		return
+	}
+	l.Def.z, l.Def.isDirty = top+1, true
+	ll.sort()
+}
+
+// Lower puts given layer l below all other layers of given layers ll
+// by giving it a z-level lower than any other layer's z-level.  Since
+// l may have covered other layers all layers are flagged dirty.
+func (ll *Layers) Lower(l *Layer) {
+	if ll == nil || l == nil {
+		return
+	}
+	bottom, isBottom := l.Def.z, true
+	ll.For(func(o *Layer) (stop bool) {
+		if o == l || o.Def.z > bottom {
+			return
+		}
+		bottom, isBottom = o.Def.z, false
+		return
+	})
+	if isBottom {
+		return
+	}
+	l.Def.z = bottom - 1
+	ll.For(func(o *Layer) (stop bool) {
+		o.Def.isDirty = true
+		return
+	})
+	ll.sort()
+}
+
+// Layered returns the Layered implementation providing given layer l
+// or nil if l is not in given layers ll.
+func (ll *Layers) Layered(l *Layer) Layered {
+	if ll == nil {
+		return nil
+	}
+	for _, o := range ll.oo {
+		if ll.ll[o] == l {
+			return o
+		}
+	}
+	return nil
+}
+
 func (ll *Layers) Containing(d Dimer) *Layer {
 	if ll == nil {
 		return nil
@@ -234,14 +310,16 @@ func (ll *Layers) isDirty() bool {
 	return dirty
 }
 
+// reflow lays out given layers ll and the layers they provide
+// themselves reporting dirty layers to given callback dirty.
 func (ll *Layers) reflow(dirty func(Dimer)) (err error) {
 	if ll == nil {
 		return nil
 	}
-	before := map[Dimer]*dim{}
+	before, nested := map[Dimer]*dim{}, []*Layers{}
 	ll.For(func(l *Layer) (stop bool) {
 		l.layoutRoot()
-		bfr, _, e := l.reflowLayer()
+		bfr, nll, e := l.reflowLayer()
 		if e != nil {
 			err = e
 			return true
@@ -249,6 +327,9 @@ func (ll *Layers) reflow(dirty func(Dimer)) (err error) {
 		for k, v := range bfr {
 			before[k] = v
 		}
+		if nll != nil {
+			nested = append(nested, nll)
+		}
 		return false
 	})
 	if err != nil {
@@ -262,7 +343,7 @@ func (ll *Layers) reflow(dirty func(Dimer)) (err error) {
 			})
 			return
 		})
-		return nil
+		return reflowNested(nested, nil)
 	}
 	dll := []*Layer{}
 	ll.For(func(l *Layer) (stop bool) {
@@ -282,13 +363,23 @@ func (ll *Layers) reflow(dirty func(Dimer)) (err error) {
 		}
 		return false
 	})
-	sort.Slice(dll, func(i, j int) bool {
+	sort.SliceStable(dll, func(i, j int) bool {
 		return dll[i].Def.z < dll[j].Def.z
 	})
 	ll.sort()
 	for _, l := range dll {
 		dirty(l.Root)
 	}
+	return reflowNested(nested, dirty)
+}
+
+// reflowNested reflows given layers provided by layers.
+func reflowNested(nested []*Layers, dirty func(Dimer)) error {
+	for _, ll := range nested {
+		if err := ll.reflow(dirty); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -421,6 +512,10 @@ func (l *Layer) layoutRoot() {
 		l.Root.Dim().SetWidth(width).SetHeight(height)
 		return
 	}
+	if l.Def.anchor != nil {
+		l.layoutAnchored()
+		return
+	}
 	l.Root.Dim().setOrigin(l.Def.x, l.Def.y)
 	l.Root.Dim().SetWidth(l.Def.width).SetHeight(l.Def.height)
 }
@@ -0,0 +1,80 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package lyt
+
+import (
+	"testing"
+
+	. "github.com/slukits/gounit"
+)
+
+type layeredFX struct {
+	Dimer
+	layer *Layer
+}
+
+func (l *layeredFX) Layer() *Layer { return l.layer }
+
+type layered struct{ Suite }
+
+func (s *layered) SetUp(t *T) { t.Parallel() }
+
+// overlapping returns a layout of given number of layers which are
+// all positioned at the same screen area.
+func overlapping(n int) (*Manager, []*Layer) {
+	fx := sf.New()
+	ll := []*Layer{}
+	for i := 0; i < n; i++ {
+		l := &layeredFX{Dimer: df.FillingOne()}
+		l.layer = &Layer{Dimer: df.New(), Def: NewLayerPos(5, 5, 10, 5)}
+		fx.dd = append(fx.dd, l)
+		ll = append(ll, l.layer)
+	}
+	return mf.ScreenOf(fx), ll
+}
+
+func (s *layered) Encloses_top_most_layer_at_given_position(t *T) {
+	m, ll := overlapping(2)
+	t.FatalOn(m.Reflow(nil))
+	t.True(m.Layers.Encloses(7, 7) == ll[1])
+	ll[0].Def.SetZ(1)
+	t.True(m.Layers.Encloses(7, 7) == ll[0])
+	t.True(m.Layers.Encloses(1, 1) == nil)
+}
+
+func (s *layered) Raises_layer_on_top_of_other_layers(t *T) {
+	m, ll := overlapping(3)
+	t.FatalOn(m.Reflow(nil))
+	m.Layers.Raise(ll[0])
+	t.True(m.Layers.Encloses(7, 7) == ll[0])
+	t.True(m.IsDirty())
+	t.FatalOn(m.Reflow(nil))
+	t.True(m.Layers.Encloses(7, 7) == ll[0])
+}
+
+func (s *layered) Lowers_layer_below_other_layers(t *T) {
+	m, ll := overlapping(2)
+	t.FatalOn(m.Reflow(nil))
+	m.Layers.Lower(ll[1])
+	t.True(m.Layers.Encloses(7, 7) == ll[0])
+	t.FatalOn(m.Reflow(nil))
+	t.True(m.Layers.Encloses(7, 7) == ll[0])
+}
+
+func (s *layered) Lays_out_layers_provided_by_layers(t *T) {
+	m, ll := overlapping(1)
+	nested := &layeredFX{Dimer: df.New()}
+	nested.layer = &Layer{Dimer: df.New(), Def: NewLayerPos(6, 6, 3, 3)}
+	ll[0].Dimer = nested
+	t.FatalOn(m.Reflow(nil))
+	x, y, w, h := nested.layer.Root.Dim().Screen()
+	t.True(x == 6 && y == 6 && w == 3 && h == 3)
+	t.True(m.Layers.Encloses(7, 7) == nested.layer)
+}
+
+func TestLayered(t *testing.T) {
+	t.Parallel()
+	Run(&layered{}, t)
+}
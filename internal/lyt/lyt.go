@@ -39,19 +39,46 @@ type Chainer interface {
 	ForChained(func(Dimer) (stop bool))
 }
 
+// Switcher is optionally implemented by a Dimer which implements the
+// Stacker and the Chainer interface to choose at layout time if its
+// Dimers are stacked or chained.
+type Switcher interface {
+	Stacker
+	Chainer
+
+	// Chaining returns true if the Dimers of a Switcher are chained;
+	// otherwise they are stacked.
+	Chaining() bool
+}
+
+// chaining is a Switcher whose Dimers are chained, i.e. it doesn't
+// implement the Stacker interface.
+type chaining struct{ Chainer }
+
+// oriented returns given Dimer d as a Dimer which implements only the
+// Chainer interface if d is a chaining Switcher; otherwise d.
+func oriented(d Dimer) Dimer {
+	if s, ok := d.(Switcher); ok && s.Chaining() {
+		return chaining{s}
+	}
+	return d
+}
+
 // ErrLyt is the basic error type which is wrapped by all layout errors.
 var ErrLyt = errors.New("lty: ")
 
 // A Manager is used to calculates the layout of set Root Dimer i.e.
 // its provided Dimers origin, size, margins and clipping.  Is Root not
 // set or either its width or height is not positive a Manger's
-// operations fail.  Is set Root implementing either the Stacker or
-// Chainer interface the layout of provided Dimers by this
+// operations fail.  Is set Root implementing either the Stacker,
+// Chainer, Grider or Placer interface the layout of provided Dimers by this
 // implementation is calculated as well.  If one of these provided
 // Dimers implements either of those interfaces its provided Dimers'
 // layout is calculated also and so on.  Provided Dimers must not
-// implement both interfaces.  In the later case the Stacker supersedes
-// the Chainer; no error is reported.  Dimers overflowing their
+// implement more than one of these interfaces.  In the later case the
+// Stacker supersedes the Chainer which supersedes the Grider which
+// supersedes the Placer; no error is reported.  A Switcher is a Stacker
+// unless it is chaining.  Dimers overflowing their
 // available area are clipped, i.e. have either a partial area of their
 // wanted area available or are flagged as off-screen (see
 // Dim.IsOffScreen).  Dimers which underflow their assigned area receive
@@ -118,11 +145,13 @@ func (m *Manager) IsDirty() (dirty bool) {
 // must sum up the Stacker's layed out height.  The layed out heights of a
 // Chainer's Dimers must be the layed out height of the Chainer and all
 // layed out widths of a Chainer's Dimers must sum up to the Chainer's
-// layed out width.  Whereas the layed out width/height is the
-// width/height reduced by its clipping or increased by its relevant
-// margins if there is/are any clipping or margins.  NOTE
-// HasConsistentLayout returns also false if a Manager is not properly
-// initialized.
+// layed out width.  The layed out area of a Grider's Dimer must be the
+// area of the grid tracks it spans.  The layed out area of a Placer's
+// Dimer must be at its place inside the Placer's area.  Whereas the
+// layed out width/height is the width/height reduced by its clipping or
+// increased by its relevant margins if there is/are any clipping or
+// margins.  NOTE HasConsistentLayout returns also false if a Manager is
+// not properly initialized.
 func (m *Manager) HasConsistentLayout() bool {
 	if err := m.validate(); err != nil {
 		return false // TODO: coverage
@@ -143,6 +172,20 @@ func (m *Manager) HasConsistentLayout() bool {
 			}
 			return false
 		},
+		func(g Grider) (stop bool) {
+			if !isConsistentGrider(g) {
+				consistent = false
+				return true
+			}
+			return false
+		},
+		func(p Placer) (stop bool) {
+			if !isConsistentPlacer(p) {
+				consistent = false
+				return true
+			}
+			return false
+		},
 	)
 	return consistent
 }
@@ -238,6 +281,18 @@ func (m *Manager) reflowLayer() (
 			}
 			return false
 		},
+		func(g Grider) (stop bool) {
+			if err = layoutGrider(g); err != nil {
+				return true
+			}
+			return false
+		},
+		func(p Placer) (stop bool) {
+			if err = layoutPlacer(p); err != nil {
+				return true
+			}
+			return false
+		},
 	)
 	if err != nil {
 		return nil, nil, err
@@ -301,9 +356,9 @@ func (m *Manager) layedOutRoot() Dimer {
 	return m.Root
 }
 
-// Locate returns a path of Stacker and Chainer whose last Stacker or
-// Chainer provides given Dimer and each Stacker/Chainer in it is
-// provided by its previous Stacker/Chainer (or is root).
+// Locate returns a path of Stacker, Chainer, Grider and Placer whose
+// last container provides given Dimer and each container in it is
+// provided by its previous container (or is root).
 func (m *Manager) Locate(dr Dimer) (path []Dimer, err error) {
 	if err := m.validate(); err != nil {
 		return nil, err
@@ -335,7 +390,13 @@ func (m *Manager) Locate(dr Dimer) (path []Dimer, err error) {
 		case Chainer: // TODO: coverage
 			path = append(path, d)
 			forDD = d.ForChained
-		default: // d == Root implementing neither Stacker nor Chainer
+		case Grider:
+			path = append(path, d)
+			forDD = forGridded(d)
+		case Placer:
+			path = append(path, d)
+			forDD = forPlaced(d)
+		default: // d == Root implementing no container interface
 			return nil, nil // TODO: coverage
 		}
 		found := false
@@ -344,12 +405,15 @@ func (m *Manager) Locate(dr Dimer) (path []Dimer, err error) {
 				found = true
 				return true
 			}
-			if _, ok := d.(Stacker); ok {
+			switch d.(type) {
+			case Stacker: // Stacker supersedes Chainer and Grider
+				dd = append(dd, d)
+			case Chainer: // TODO: coverage
+				dd = append(dd, d)
+			case Grider:
+				dd = append(dd, d)
+			case Placer:
 				dd = append(dd, d)
-				return false // Stacker supersedes Chainer
-			}
-			if _, ok := d.(Chainer); ok {
-				dd = append(dd, d) // TODO: coverage
 			}
 			return false
 		})
@@ -377,24 +441,30 @@ func (m *Manager) LocateAt(x, y int) (path []Dimer, err error) {
 	}
 	last, forDD := m.Root, (func(func(d Dimer) bool))(nil)
 	for last != nil {
-		d := last
+		d, overlapping := last, false
 		path, last = append(path, d), nil
 		switch d := d.(type) {
 		case Stacker:
 			forDD = d.ForStacked
 		case Chainer:
 			forDD = d.ForChained
+		case Grider:
+			forDD = forGridded(d)
+		case Placer:
+			forDD, overlapping = forPlaced(d), true
 		default:
 			forDD = nil
 		}
 		if forDD == nil {
 			break
 		}
+		// placed Dimers may overlap, i.e. the last enclosing one in
+		// their z-order is the top-most.
 		forDD(func(d Dimer) (stop bool) {
 			dx, dy, dw, dh := d.Dim().Screen()
 			if dy <= y && dx <= x && dw+dx > x && dh+dy > y {
 				last = d
-				return true
+				return !overlapping
 			}
 			return false
 		})
@@ -402,10 +472,14 @@ func (m *Manager) LocateAt(x, y int) (path []Dimer, err error) {
 	return path, nil
 }
 
-// forContainer iterates in a breadth-first manner over all stacker and
-// chainer found in a layout.
+// forContainer iterates in a breadth-first manner over all stacker,
+// chainer and grider found in a layout.
 func (m *Manager) forContainer(
-	d Dimer, s func(Stacker) (stop bool), c func(Chainer) (stop bool),
+	d Dimer,
+	s func(Stacker) (stop bool),
+	c func(Chainer) (stop bool),
+	g func(Grider) (stop bool),
+	p func(Placer) (stop bool),
 ) *Layers {
 	if d == nil {
 		return nil // TODO: coverage
@@ -414,7 +488,7 @@ func (m *Manager) forContainer(
 	var oo Layereds
 	for len(dd) > 0 {
 		d, dd = dd[0], dd[1:]
-		switch d := d.(type) {
+		switch d := oriented(d).(type) {
 		case Stacker:
 			if s(d) {
 				return newLayers(m, oo)
@@ -425,9 +499,19 @@ func (m *Manager) forContainer(
 				return newLayers(m, oo)
 			}
 			forDD = d.ForChained
+		case Grider:
+			if g(d) {
+				return newLayers(m, oo)
+			}
+			forDD = forGridded(d)
+		case Placer:
+			if p(d) {
+				return newLayers(m, oo)
+			}
+			forDD = forPlaced(d)
 		case Layered:
 			return newLayers(m, append(oo, d))
-		default: // first d is implementing neither Stacker nor Chainer
+		default: // first d is implementing no container interface
 			return nil // TODO: coverage
 		}
 		if d, ok := d.(Layered); ok {
@@ -440,6 +524,10 @@ func (m *Manager) forContainer(
 				return false // Stacker supersedes Chainer
 			case Chainer:
 				dd = append(dd, d) // TODO: coverage
+			case Grider:
+				dd = append(dd, d)
+			case Placer:
+				dd = append(dd, d)
 			case Layered:
 				oo = append(oo, d)
 			}
@@ -467,6 +555,10 @@ func (m *Manager) ForDimer(
 			forDD = d.ForStacked
 		case Chainer:
 			forDD = d.ForChained
+		case Grider:
+			forDD = forGridded(d)
+		case Placer:
+			forDD = forPlaced(d)
 		case Layered:
 			oo = append(oo, d)
 		}
@@ -505,6 +597,10 @@ func (m *Manager) Has(dr, in Dimer) bool {
 			forDD = d.ForStacked
 		case Chainer:
 			forDD = d.ForChained
+		case Grider:
+			forDD = forGridded(d)
+		case Placer:
+			forDD = forPlaced(d)
 		}
 		if forDD == nil {
 			continue
@@ -335,6 +335,41 @@ func (s *manager) Recursively_layouts_stacked_dimer(t *T) {
 	t.True(fx.dd[1].(*stackerFX).HasConsistentLayout())
 }
 
+// switcherFX stacks or chains its Dimers dd depending on its chaining
+// flag.
+type switcherFX struct {
+	Dimer
+	dd       []Dimer
+	chaining bool
+}
+
+func (sw *switcherFX) ForStacked(cb func(Dimer) (stop bool)) {
+	for _, d := range sw.dd {
+		if cb(d) {
+			return
+		}
+	}
+}
+
+func (sw *switcherFX) ForChained(cb func(Dimer) (stop bool)) {
+	sw.ForStacked(cb)
+}
+
+func (sw *switcherFX) Chaining() bool { return sw.chaining }
+
+func (s *manager) Lays_out_switcher_according_to_its_orientation(t *T) {
+	fx := &switcherFX{Dimer: df.FillingOne(),
+		dd: []Dimer{df.FillingOne(), df.FillingOne()}}
+	m := mf.WHOf(80, 20, fx)
+	t.FatalOn(m.Reflow(nil))
+	x, y, w, h := fx.dd[1].Dim().Printable()
+	t.True(x == 0 && y == 10 && w == 80 && h == 10)
+	fx.chaining = true
+	t.FatalOn(m.Reflow(nil))
+	x, y, w, h = fx.dd[1].Dim().Printable()
+	t.True(x == 40 && y == 0 && w == 40 && h == 20)
+}
+
 func TestManager(t *testing.T) {
 	t.Parallel()
 	Run(&manager{}, t)
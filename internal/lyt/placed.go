@@ -0,0 +1,133 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package lyt
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Place positions a Dimer inside a Placer's area.  X and Y are the
+// Dimer's origin relative to the Placer's printable area; Width and
+// Height are its size whereas zero defaults to the Dimer's fixed
+// respectively minimal filling size.  Z is the Dimer's z-level among
+// its siblings, i.e. a Dimer with a higher z-level is on top of
+// overlapping Dimers with a lower z-level; of Dimers with the same
+// z-level the later provided is on top.
+type Place struct {
+	X, Y, Width, Height, Z int
+}
+
+// Placer is implemented by components who consist of Dimers which are
+// placed at explicit positions inside its area.  Placed Dimers may
+// overlap and are clipped at the Placer's area.  A placed Dimer which
+// overflows the area at the right or bottom is clipped there while a
+// Dimer overflowing at the left or top keeps its origin outside the
+// area and it is up to the client to not print the part outside of
+// the area.  A placed Dimer which is entirely outside is off-screen.
+type Placer interface {
+	Dimer
+
+	// ForPlaced provides the placed Dimers together with their
+	// placement.
+	ForPlaced(func(Dimer, Place) (stop bool))
+}
+
+// ForPlaced calls back for the placed Dimers of given Placer p in their
+// z-order, i.e. from the bottom-most to the top-most, until the
+// callback asks to stop.
+func ForPlaced(p Placer, cb func(Dimer, Place) (stop bool)) {
+	type zPlaced struct {
+		d Dimer
+		p Place
+	}
+	pp := []zPlaced{}
+	p.ForPlaced(func(d Dimer, p Place) (stop bool) {
+		pp = append(pp, zPlaced{d: d, p: p})
+		return false
+	})
+	sort.SliceStable(pp, func(i, j int) bool {
+		return pp[i].p.Z < pp[j].p.Z
+	})
+	for _, p := range pp {
+		if cb(p.d, p.p) {
+			return
+		}
+	}
+}
+
+// forPlaced adapts given Placer p's placed Dimers in their z-order to
+// the callback signature of ForStacked and ForChained.
+func forPlaced(p Placer) func(func(Dimer) (stop bool)) {
+	return func(cb func(Dimer) (stop bool)) {
+		ForPlaced(p, func(d Dimer, _ Place) (stop bool) { return cb(d) })
+	}
+}
+
+func layoutPlacer(p Placer) error {
+	x, y, width, height := area(p)
+	var err error
+	p.ForPlaced(func(d Dimer, pl Place) (stop bool) {
+		if pl.Width < 0 || pl.Height < 0 {
+			err = fmt.Errorf("%w%s", ErrDim,
+				"placement-layout: size must not be negative")
+			return true
+		}
+		w, h := placedSize(d.Dim(), pl)
+		if pl.X+w <= 0 || pl.Y+h <= 0 || pl.X >= width || pl.Y >= height {
+			d.Dim().setOffScreen()
+			return false
+		}
+		if w > width-pl.X {
+			w = width - pl.X
+		}
+		if h > height-pl.Y {
+			h = height - pl.Y
+		}
+		d.Dim().setOrigin(x+pl.X, y+pl.Y)
+		d.Dim().setLayedOutWidth(w, 0)
+		d.Dim().setLayedOutHeight(h, 0)
+		return false
+	})
+	return err
+}
+
+// placedSize returns the size of a Dimer with given Dim d at given
+// place pl which defaults to d's fixed respectively minimal filling
+// size.
+func placedSize(d *Dim, pl Place) (width, height int) {
+	width, height = pl.Width, pl.Height
+	if width == 0 {
+		width = d.width
+		if d.fillsWidth > 0 {
+			width = d.fillsWidth
+		}
+	}
+	if height == 0 {
+		height = d.height
+		if d.fillsHeight > 0 {
+			height = d.fillsHeight
+		}
+	}
+	return width, height
+}
+
+func isConsistentPlacer(p Placer) bool {
+	x, y, width, height := area(p)
+	ok := true
+	p.ForPlaced(func(d Dimer, pl Place) (stop bool) {
+		if d.Dim().IsOffScreen() {
+			return false
+		}
+		dx, dy, dw, dh := d.Dim().Screen()
+		if dx != x+pl.X || dy != y+pl.Y ||
+			dx+dw > x+width || dy+dh > y+height {
+			ok = false
+			return true
+		}
+		return false
+	})
+	return ok
+}
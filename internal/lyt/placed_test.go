@@ -0,0 +1,111 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package lyt
+
+import (
+	"testing"
+
+	. "github.com/slukits/gounit"
+	"github.com/slukits/lines/internal/api"
+)
+
+type placerFX struct {
+	Dimer
+	dd []Dimer
+	pp []Place
+	gg api.Gaps
+}
+
+func (pf *placerFX) ForPlaced(cb func(Dimer, Place) (stop bool)) {
+	for i, d := range pf.dd {
+		if cb(d, pf.pp[i]) {
+			return
+		}
+	}
+}
+
+func (pf *placerFX) Gaps() api.Gaps { return pf.gg }
+
+// Place adds given Dimer d at given place p.
+func (pf *placerFX) Place(d Dimer, p Place) *placerFX {
+	pf.dd, pf.pp = append(pf.dd, d), append(pf.pp, p)
+	return pf
+}
+
+type placing struct{ Suite }
+
+func (s *placing) SetUp(t *T) { t.Parallel() }
+
+func (s *placing) Fails_if_size_is_negative(t *T) {
+	fx := (&placerFX{Dimer: df.Screen()}).
+		Place(df.FillingOne(), Place{Width: -1})
+	t.ErrIs(mf.ScreenOf(fx).Reflow(nil), ErrDim)
+}
+
+func (s *placing) Places_relative_to_printable_area(t *T) {
+	fx := (&placerFX{Dimer: df.Screen(), gg: api.Gaps{Top: 1, Left: 2}}).
+		Place(df.FillingOne(), Place{X: 3, Y: 4, Width: 10, Height: 5}).
+		Place(df.FixedWH(6, 2), Place{X: 20, Y: 1})
+	m := mf.ScreenOf(fx)
+	t.FatalOn(m.Reflow(nil))
+	x, y, w, h := fx.dd[0].Dim().Screen()
+	t.True(x == 5 && y == 5 && w == 10 && h == 5)
+	x, y, w, h = fx.dd[1].Dim().Screen()
+	t.True(x == 22 && y == 2 && w == 6 && h == 2)
+	t.True(m.HasConsistentLayout())
+}
+
+func (s *placing) Clips_placed_at_its_area(t *T) {
+	fx := (&placerFX{Dimer: df.Screen()}).
+		Place(df.FillingOne(), Place{X: 75, Y: 22, Width: 10, Height: 5}).
+		Place(df.FillingOne(), Place{X: 80, Y: 1, Width: 10, Height: 5}).
+		Place(df.FillingOne(), Place{X: -1, Y: -2, Width: 10, Height: 5}).
+		Place(df.FillingOne(), Place{X: -10, Y: 1, Width: 10, Height: 5})
+	m := mf.ScreenOf(fx)
+	t.FatalOn(m.Reflow(nil))
+	x, y, w, h := fx.dd[0].Dim().Printable()
+	t.True(x == 75 && y == 22 && w == 5 && h == 3)
+	t.True(fx.dd[1].Dim().IsOffScreen())
+	x, y, w, h = fx.dd[2].Dim().Printable()
+	t.True(x == -1 && y == -2 && w == 10 && h == 5)
+	t.True(fx.dd[3].Dim().IsOffScreen())
+	t.True(m.HasConsistentLayout())
+}
+
+func (s *placing) Locates_top_most_placed_at_position(t *T) {
+	fx := (&placerFX{Dimer: df.Screen()}).
+		Place(df.FillingOne(), Place{X: 0, Y: 0, Width: 10, Height: 5,
+			Z: 1}).
+		Place(df.FillingOne(), Place{X: 5, Y: 2, Width: 10, Height: 5})
+	m := mf.ScreenOf(fx)
+	t.FatalOn(m.Reflow(nil))
+	path, err := m.LocateAt(6, 3)
+	t.FatalOn(err)
+	t.True(path[len(path)-1] == fx.dd[0])
+	path, err = m.LocateAt(12, 3)
+	t.FatalOn(err)
+	t.True(path[len(path)-1] == fx.dd[1])
+	path, err = m.Locate(fx.dd[1])
+	t.FatalOn(err)
+	t.True(len(path) == 1 && path[0] == fx)
+}
+
+func (s *placing) Provides_placed_in_z_order(t *T) {
+	fx := (&placerFX{Dimer: df.Screen()}).
+		Place(df.FillingOne(), Place{Z: 2}).
+		Place(df.FillingOne(), Place{}).
+		Place(df.FillingOne(), Place{Z: 2})
+	dd := []Dimer{}
+	ForPlaced(fx, func(d Dimer, _ Place) (stop bool) {
+		dd = append(dd, d)
+		return false
+	})
+	t.True(dd[0] == fx.dd[1] && dd[1] == fx.dd[0] && dd[2] == fx.dd[2])
+}
+
+func TestPlacing(t *testing.T) {
+	t.Parallel()
+	Run(&placing{}, t)
+}
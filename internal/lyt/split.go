@@ -0,0 +1,222 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package lyt
+
+// A split is the border between two adjacent Dimers of a Stacker or
+// Chainer.  Its position is the offset of the bottom (Stacker)
+// respectively right (Chainer) edge of the split's first Dimer relative
+// to the beginning of the container's nested area, e.g. a Stacker with
+// three Dimers of the heights 5, 10 and 10 has the splits 5 and 15.
+//
+// Moving a split resizes its two adjacent Dimers whereas none of them
+// shrinks below its minimal filling size (one for a fixed Dimer) nor
+// grows beyond its maximum.  The new sizes are kept by setting the
+// size of a fixed Dimer respectively by setting the weights of all
+// filling Dimers of the container to their sizes beyond their minimal
+// filling size, i.e. a resized container keeps the proportions of its
+// filling Dimers.  Moving a split takes effect with the next Reflow
+// of the layout.
+
+// Splits returns the split positions of given Stacker or Chainer d as
+// calculated by the last Reflow.  Splits returns nil if d is neither
+// or has less than two nested Dimers.
+func Splits(d Dimer) []int {
+	sp := splittingOf(d)
+	if sp == nil {
+		return nil
+	}
+	pp := make([]int, len(sp.dd)-1)
+	for i := range pp {
+		pp[i] = sp.position(i)
+	}
+	return pp
+}
+
+// MoveSplit moves the split with given index i of given Stacker or
+// Chainer d by given delta and returns the distance the split was
+// actually moved.
+func MoveSplit(d Dimer, i, delta int) int {
+	sp := splittingOf(d)
+	if sp == nil {
+		return 0
+	}
+	moved := sp.move(i, delta)
+	if moved != 0 {
+		sp.apply()
+	}
+	return moved
+}
+
+// SetSplits moves the splits of given Stacker or Chainer d to given
+// positions pp.  Positions beyond d's splits are ignored.
+func SetSplits(d Dimer, pp ...int) {
+	sp := splittingOf(d)
+	if sp == nil {
+		return
+	}
+	for i, p := range pp {
+		if i >= len(sp.dd)-1 {
+			break
+		}
+		sp.move(i, p-sp.position(i))
+	}
+	sp.apply()
+}
+
+// SplitAt returns the index of the split of given Stacker or Chainer d
+// whose handle contains given coordinates x and y.  A split's handle
+// is the last screen line (Stacker) respectively screen column
+// (Chainer) of the split's first Dimer.  SplitAt returns -1 if there
+// is no such split.
+func SplitAt(d Dimer, x, y int) int {
+	sp := splittingOf(d)
+	if sp == nil {
+		return -1
+	}
+	for i, d := range sp.dd[:len(sp.dd)-1] {
+		dx, dy, w, h := d.Dim().Screen()
+		if w == 0 || h == 0 {
+			continue
+		}
+		if sp.stacked && y == dy+h-1 && x >= dx && x < dx+w {
+			return i
+		}
+		if !sp.stacked && x == dx+w-1 && y >= dy && y < dy+h {
+			return i
+		}
+	}
+	return -1
+}
+
+// splitting provides the nested Dimers of a Stacker or Chainer
+// together with their layed out sizes in the stacking respectively
+// chaining dimension.
+type splitting struct {
+	dd      []Dimer
+	ss      []int
+	stacked bool
+}
+
+func splittingOf(d Dimer) *splitting {
+	sp := &splitting{}
+	switch d := oriented(d).(type) {
+	case Stacker:
+		sp.stacked = true
+		d.ForStacked(func(d Dimer) (stop bool) {
+			sp.dd = append(sp.dd, d)
+			sp.ss = append(sp.ss, d.Dim().layoutHeight())
+			return false
+		})
+	case Chainer:
+		d.ForChained(func(d Dimer) (stop bool) {
+			sp.dd = append(sp.dd, d)
+			sp.ss = append(sp.ss, d.Dim().layoutWidth())
+			return false
+		})
+	default:
+		return nil
+	}
+	if len(sp.dd) < 2 {
+		return nil
+	}
+	return sp
+}
+
+func (sp *splitting) position(i int) (p int) {
+	for _, s := range sp.ss[:i+1] {
+		p += s
+	}
+	return p
+}
+
+// fills returns the minimal filling size of the j-th Dimer which is
+// zero if it is not filling.
+func (sp *splitting) fills(j int) int {
+	if sp.stacked {
+		return sp.dd[j].Dim().fillsHeight
+	}
+	return sp.dd[j].Dim().fillsWidth
+}
+
+// min returns the size the j-th Dimer can't shrink below.
+func (sp *splitting) min(j int) int {
+	if f := sp.fills(j); f > 0 {
+		return f
+	}
+	return 1
+}
+
+// room returns how much the j-th Dimer can grow beyond its minimal
+// filling size or -1 if it can grow arbitrarily.
+func (sp *splitting) room(j int) int {
+	if sp.fills(j) == 0 {
+		return -1
+	}
+	if sp.stacked {
+		return sp.dd[j].Dim().fillingRoomHeight()
+	}
+	return sp.dd[j].Dim().fillingRoomWidth()
+}
+
+// move moves the i-th split by given delta and returns the distance
+// it was actually moved.
+func (sp *splitting) move(i, delta int) int {
+	if i < 0 || i >= len(sp.dd)-1 || delta == 0 {
+		return 0
+	}
+	grows, shrinks, sign := i, i+1, 1
+	if delta < 0 {
+		grows, shrinks, sign, delta = i+1, i, -1, -delta
+	}
+	if max := sp.ss[shrinks] - sp.min(shrinks); delta > max {
+		delta = max
+	}
+	if room := sp.room(grows); room >= 0 {
+		if max := sp.fills(grows) + room - sp.ss[grows]; delta > max {
+			delta = max
+		}
+	}
+	if delta <= 0 {
+		return 0
+	}
+	sp.ss[grows] += delta
+	sp.ss[shrinks] -= delta
+	return sign * delta
+}
+
+// apply sets the sizes of fixed Dimers and the weights of filling
+// Dimers to reproduce the current sizes at the next Reflow.
+func (sp *splitting) apply() {
+	for j, d := range sp.dd {
+		fills := sp.fills(j)
+		if fills == 0 {
+			if sp.stacked {
+				d.Dim().SetHeight(sp.ss[j])
+			} else {
+				d.Dim().SetWidth(sp.ss[j])
+			}
+			continue
+		}
+		weight := sp.ss[j] - fills
+		if room := sp.room(j); room >= 0 && weight > room {
+			weight = room
+		}
+		if weight <= 0 {
+			weight = -1 // interpreted as zero weight
+		}
+		if sp.stacked {
+			sp.setWeight(&d.Dim().weightHeight, d.Dim(), weight)
+		} else {
+			sp.setWeight(&d.Dim().weightWidth, d.Dim(), weight)
+		}
+	}
+}
+
+func (sp *splitting) setWeight(stored *int, d *Dim, weight int) {
+	if *stored == weight {
+		return
+	}
+	*stored, d.isDirty = weight, true
+}
@@ -0,0 +1,116 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package lyt
+
+import (
+	"testing"
+
+	. "github.com/slukits/gounit"
+)
+
+type split struct{ Suite }
+
+func (s *split) SetUp(t *T) { t.Parallel() }
+
+func (s *split) Has_no_splits_for_less_than_two_nested_dimers(t *T) {
+	t.Eq(0, len(Splits(sf.New(df.FillingOne()))))
+	t.Eq(0, len(Splits(df.FillingOne())))
+	t.Eq(-1, SplitAt(sf.New(df.FillingOne()), 0, 0))
+}
+
+func (s *split) Provides_positions_of_stacked_splits(t *T) {
+	fx := sf.New(df.FixedH(5), df.FillingOne(), df.FixedH(10))
+	t.FatalOn((&Manager{Root: fx}).Reflow(nil))
+	t.Eq([]int{5, 15}, Splits(fx))
+}
+
+func (s *split) Moves_split_between_height_fillers(t *T) {
+	fx := sf.New(df.FillingOne(), df.FillingOne())
+	m := &Manager{Root: fx}
+	t.FatalOn(m.Reflow(nil))
+	t.Eq([]int{13}, Splits(fx))
+	t.Eq(5, MoveSplit(fx, 0, 5))
+	t.True(m.IsDirty())
+	t.FatalOn(m.Reflow(nil))
+	t.Eq([]int{18}, Splits(fx))
+	t.True(fx.HasConsistentLayout())
+}
+
+func (s *split) Respects_minimal_filling_size(t *T) {
+	fx := sf.New(df.FillingH(3), df.FillingH(5))
+	m := &Manager{Root: fx}
+	t.FatalOn(m.Reflow(nil))
+	t.Eq(8, MoveSplit(fx, 0, 30))
+	t.FatalOn(m.Reflow(nil))
+	_, _, _, h := fx.dd[1].Dim().Printable()
+	t.Eq(5, h)
+	t.Eq(-17, MoveSplit(fx, 0, -30))
+	t.FatalOn(m.Reflow(nil))
+	_, _, _, h = fx.dd[0].Dim().Printable()
+	t.Eq(3, h)
+	_, _, _, h = fx.dd[1].Dim().Printable()
+	t.Eq(22, h)
+	t.Eq(0, MoveSplit(fx, 0, -1))
+}
+
+func (s *split) Respects_maximum_of_growing_filler(t *T) {
+	fx := sf.New(df.FillingOne(), df.FillingOne())
+	fx.dd[0].Dim().SetMaxHeight(15)
+	m := &Manager{Root: fx}
+	t.FatalOn(m.Reflow(nil))
+	t.Eq(2, MoveSplit(fx, 0, 5))
+}
+
+func (s *split) Resizes_fixed_neighbour(t *T) {
+	fx := sf.New(df.FixedH(5), df.FillingOne())
+	m := &Manager{Root: fx}
+	t.FatalOn(m.Reflow(nil))
+	t.Eq(3, MoveSplit(fx, 0, 3))
+	t.FatalOn(m.Reflow(nil))
+	t.Eq(8, fx.dd[0].Dim().Height())
+	t.Not.True(fx.dd[0].Dim().IsFillingHeight())
+	t.Eq(17, fx.dd[1].Dim().Height())
+}
+
+func (s *split) Keeps_proportions_of_fillers_on_resize(t *T) {
+	fx := cf.New(df.FillingOne(), df.FillingOne())
+	m := &Manager{Root: fx}
+	t.FatalOn(m.Reflow(nil))
+	t.Eq(-20, MoveSplit(fx, 0, -20))
+	t.FatalOn(m.Reflow(nil))
+	t.Eq([]int{20}, Splits(fx))
+	m.Width = 160
+	fx.Dim().SetWidth(160)
+	t.FatalOn(m.Reflow(nil))
+	t.Eq([]int{40}, Splits(fx))
+	t.True(fx.HasConsistentLayout())
+}
+
+func (s *split) Restores_given_split_positions(t *T) {
+	fx := cf.New(df.FillingOne(), df.FillingOne(), df.FixedW(10))
+	m := &Manager{Root: fx}
+	t.FatalOn(m.Reflow(nil))
+	SetSplits(fx, 30, 50, 77)
+	t.FatalOn(m.Reflow(nil))
+	t.Eq([]int{30, 50}, Splits(fx))
+	t.Eq(30, fx.dd[2].Dim().Width())
+}
+
+func (s *split) Locates_split_handle(t *T) {
+	fx := sf.New(df.FillingOne(), df.FillingOne())
+	t.FatalOn((&Manager{Root: fx}).Reflow(nil))
+	t.Eq(0, SplitAt(fx, 10, 12))
+	t.Eq(-1, SplitAt(fx, 10, 13))
+	t.Eq(-1, SplitAt(fx, 10, 24))
+	cfx := cf.New(df.FillingOne(), df.FillingOne())
+	t.FatalOn((&Manager{Root: cfx}).Reflow(nil))
+	t.Eq(0, SplitAt(cfx, 39, 3))
+	t.Eq(-1, SplitAt(cfx, 40, 3))
+}
+
+func TestSplit(t *testing.T) {
+	t.Parallel()
+	Run(&split{}, t)
+}
@@ -9,6 +9,12 @@ import "fmt"
 var ErrDim = fmt.Errorf("%w"+"dimensions: ", ErrLyt)
 
 func layoutStacker(s Stacker) (err error) {
+	if v, ok := s.(Viewporter); ok {
+		if offset, _ := Scrolled(v); offset > 0 {
+			layoutViewport(v, offset)
+			return nil
+		}
+	}
 	minHeight, filler, n, err := minStackHeight(s)
 	if err != nil {
 		return err
@@ -23,7 +29,7 @@ func layoutStacker(s Stacker) (err error) {
 		return nil
 	}
 	if filler > 0 {
-		layoutFilledStacker(s, minHeight, filler)
+		layoutFilledStacker(s, minHeight, n)
 		return nil
 	}
 	layoutFixedStackerUnderflowing(s, minHeight, n)
@@ -38,27 +44,42 @@ func layoutStackedOffScreen(s Stacker) {
 }
 
 // layoutFilledStacker not overflowing distributes remaining height
-// equally equally amongst fillers.
-func layoutFilledStacker(s Stacker, minHeight, filler int) {
+// amongst fillers according to their weights.  Is there height left
+// after all fillers reached their maximum height it is distributed as
+// margins.
+func layoutFilledStacker(s Stacker, minHeight, n int) {
 	x, y, stackerWidth, stackerHeight := area(s)
-	distribute := (stackerHeight - minHeight) / filler
-	distributeModulo := (stackerHeight - minHeight) % filler
-	shiftY := 0
+	ff := []filling{}
+	s.ForStacked(func(d Dimer) (stop bool) {
+		if d.Dim().fillsHeight == 0 {
+			return false
+		}
+		_, weight := d.Dim().Weight()
+		ff = append(ff, filling{weight: weight,
+			room: d.Dim().fillingRoomHeight()})
+		return false
+	})
+	extra, remaining := distributeFilling(ff, stackerHeight-minHeight)
+	var mm margins
+	if remaining > 0 {
+		mm = calculateMargins(remaining, n)
+	}
+	shiftY, i, j := 0, 0, 0
 	s.ForStacked(func(d Dimer) (stop bool) {
 		d.Dim().setOrigin(x, y+shiftY)
 		d.Dim().setLayedOutWidth(stackerWidth, 0)
-		if d.Dim().fillsHeight == 0 { // fixed height Dimer
-			d.Dim().setLayedOutHeight(d.Dim().height, 0)
-			shiftY += d.Dim().layoutHeight()
-			return false
+		height := d.Dim().height
+		if d.Dim().fillsHeight > 0 {
+			height = d.Dim().fillsHeight + extra[j]
+			j++
 		}
-		fillerHeight := distribute + d.Dim().fillsHeight
-		if distributeModulo > 0 {
-			distributeModulo--
-			fillerHeight++
+		if mm == nil {
+			d.Dim().setLayedOutHeight(height, 0)
+		} else {
+			d.Dim().setLayedOutHeight(height+mm.sum(i), mm.bottom(i))
 		}
-		d.Dim().setLayedOutHeight(fillerHeight, 0)
 		shiftY += d.Dim().layoutHeight()
+		i++
 		return false
 	})
 }
@@ -86,6 +107,67 @@ func (m margins) sum(idx int) int { return m[idx][0] + m[idx][1] }
 func (m margins) right(idx int) int  { return m[idx][1] }
 func (m margins) bottom(idx int) int { return m[idx][1] }
 
+// filling describes a filling Dimer's claim to left over space, i.e.
+// its weight and the room it has to grow which is negative if it can
+// grow arbitrarily.
+type filling struct{ weight, room int }
+
+// distributeFilling distributes given left over space l amongst given
+// fillers ff proportional to their weights whereas no filler receives
+// more than its room.  Space which can't be distributed evenly is given
+// unit by unit to the first fillers.  Returned are the extra space for
+// each filler and the remaining space which couldn't be distributed
+// since all fillers reached their maximum.
+func distributeFilling(ff []filling, l int) (extra []int, remaining int) {
+	extra, active := make([]int, len(ff)), make([]bool, len(ff))
+	for i, f := range ff {
+		active[i] = f.room != 0
+	}
+	for l > 0 {
+		weight := 0
+		for i, f := range ff {
+			if active[i] {
+				weight += f.weight
+			}
+		}
+		if weight == 0 {
+			return extra, l
+		}
+		// fillers whose share reaches their room are set to their
+		// maximum and the remaining space is distributed again.
+		capped := 0
+		for i, f := range ff {
+			if !active[i] || f.room < 0 || l*f.weight/weight < f.room {
+				continue
+			}
+			extra[i], active[i] = f.room, false
+			capped += f.room
+		}
+		if capped > 0 {
+			l -= capped
+			continue
+		}
+		distributed := 0
+		for i, f := range ff {
+			if active[i] {
+				extra[i] = l * f.weight / weight
+				distributed += extra[i]
+			}
+		}
+		for i := range ff {
+			if distributed == l {
+				break
+			}
+			if active[i] {
+				extra[i]++
+				distributed++
+			}
+		}
+		return extra, 0
+	}
+	return extra, 0
+}
+
 // calculateMargins for a Stacker/Chainer with only fixed components in
 // a way that the space for margins is evenly distributed around and
 // between the components, e.g. 3 components with 20 units available
@@ -99,22 +181,20 @@ func calculateMargins(l, n int) margins {
 	modulo := l % (n + 1)
 	mm := make([][2]int, n)
 	for i := 0; i < n; i++ {
-		if i == 0 {
+		switch {
+		case i == 0:
 			mm[i][0] = distribute
 			mm[i][1] = distribute / 2
-			continue
-		}
-		if i < n-1 {
+		case i < n-1:
 			mm[i][0] = distribute - distribute/2
 			mm[i][1] = distribute / 2
-		}
-		if i+1 == n {
+		default:
 			mm[i][0] = distribute - distribute/2
 			mm[i][1] = distribute
 		}
-		if i+modulo == n {
+		// the last modulo components get one unit more margin
+		if i >= n-modulo {
 			mm[i][1] += 1
-			modulo--
 		}
 	}
 	return mm
@@ -174,6 +174,18 @@ func (s *stacked) With_underflowing_height_have_tb_margins(t *T) {
 	}
 }
 
+func (s *stacked) Calculates_margins_summing_up_to_left_over(t *T) {
+	for n := 1; n < 6; n++ {
+		for l := 0; l < 30; l++ {
+			sum, mm := 0, calculateMargins(l, n)
+			for i := range mm {
+				sum += mm.sum(i)
+			}
+			t.Eq(l, sum)
+		}
+	}
+}
+
 func (s *stacked) With_underflowing_height_consume_all_height(t *T) {
 	// single margin (fx1), distributed margins (fx2), exact fit (fx3)
 	fx1, fx2 := sf.New(df.Fixed()), sf.New(df.Fixed(), df.Fixed())
@@ -230,6 +242,60 @@ func (s *stacked) Height_filler_have_height_evenly_distributed(t *T) {
 	}
 }
 
+func (s *stacked) Height_filler_have_height_distributed_by_weights(
+	t *T,
+) {
+	// i.e. min-height 2 available 25 => 23 by 1:3: 5+1, 17 => 7, 18
+	fx := sf.New(df.FillingOne(), df.FillingOne())
+	fx.dd[1].Dim().SetWeight(1, 3)
+	t.FatalOn((&Manager{Root: fx}).Reflow(nil))
+	_, _, _, h0 := fx.dd[0].Dim().Printable()
+	_, _, _, h1 := fx.dd[1].Dim().Printable()
+	t.Eq(7, h0)
+	t.Eq(18, h1)
+	t.True(fx.HasConsistentLayout())
+}
+
+func (s *stacked) Height_filler_do_not_exceed_their_maximum(t *T) {
+	fx := sf.New(df.FillingOne(), df.FillingOne(), df.FillingOne())
+	fx.dd[0].Dim().SetMaxHeight(5)
+	fx.dd[2].Dim().SetWeight(1, 2).SetMaxHeight(12)
+	t.FatalOn((&Manager{Root: fx}).Reflow(nil))
+	for i, exp := range []int{5, 8, 12} {
+		_, _, _, h := fx.dd[i].Dim().Printable()
+		t.Eq(exp, h)
+	}
+	t.True(fx.HasConsistentLayout())
+}
+
+func (s *stacked) With_maxed_out_height_filler_have_tb_margins(t *T) {
+	// min-height 9 available 25 => filler grows 4; 12 margins left
+	fx := sf.New(df.FillingOne(), df.Fixed())
+	fx.dd[0].Dim().SetMaxHeight(5)
+	t.FatalOn((&Manager{Root: fx}).Reflow(nil))
+	_, _, _, h := fx.dd[0].Dim().Printable()
+	t.Eq(5, h)
+	exp := [][2]int{{4, 2}, {2, 4}}
+	for i, d := range fx.dd {
+		mt, _, mb, _ := d.Dim().Margin()
+		t.Eq(exp[i][0], mt)
+		t.Eq(exp[i][1], mb)
+	}
+	t.Eq(fx.Height(), fx.SumLayoutHeights())
+	t.True(fx.HasConsistentLayout())
+}
+
+func (s *stacked) Width_filler_do_not_exceed_their_maximum(t *T) {
+	fx := sf.New(df.FillingOne())
+	fx.dd[0].Dim().SetMaxWidth(40)
+	t.FatalOn((&Manager{Root: fx}).Reflow(nil))
+	_, _, w, _ := fx.dd[0].Dim().Printable()
+	t.Eq(40, w)
+	_, mr, _, ml := fx.dd[0].Dim().Margin()
+	t.True(mr == 20 && ml == 20)
+	t.True(fx.HasConsistentLayout())
+}
+
 func (s *stacked) Width_fillers_have_no_left_or_right_margins(t *T) {
 	fx := sf.New(df.Filling(), df.Filling(), df.Filling())
 	for _, d := range fx.dd {
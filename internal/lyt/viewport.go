@@ -0,0 +1,182 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package lyt
+
+// Viewporter is implemented by a Stacker whose stacked Dimers are laid
+// out on a virtual canvas which may be higher than the Stacker's area.
+// On the canvas each stacked Dimer has its fixed height respectively
+// its minimal filling height.  Displayed is the part of the canvas
+// which starts with the canvas line Offset; the Dimers above and below
+// the displayed part are off-screen.  A Dimer which is partially
+// displayed at the bottom is clipped while a Dimer which is partially
+// displayed at the top is laid out at its canvas position, i.e. its
+// origin is above the Viewporter's area and it is up to the client to
+// not print the part outside of this area.  Note a Viewporter scrolls
+// vertically only, i.e. its canvas has the width of its area and a
+// stacked Dimer which is wider is clipped at the right.
+type Viewporter interface {
+	Stacker
+
+	// Offset returns the canvas line which is displayed first.
+	Offset() int
+
+	// SetOffset sets the canvas line which is displayed first.
+	SetOffset(int)
+}
+
+// ScrollTo makes given Viewporter v display its canvas starting with
+// the canvas line with given offset.  The offset is clamped to zero and
+// the greatest offset which may be displayed first, see [Scrolled].  v
+// is flagged dirty if its displayed part of the canvas changes, i.e.
+// the next Reflow of its layout scrolls.  Returned is the offset which
+// was set.  Is v not laid out yet given offset is set unclamped.
+func ScrollTo(v Viewporter, offset int) int {
+	vp := viewportOf(v)
+	if offset > vp.max && vp.height > 0 {
+		offset = vp.max
+	}
+	if offset < 0 {
+		offset = 0
+	}
+	if offset != v.Offset() {
+		v.SetOffset(offset)
+	}
+	if offset != vp.offset {
+		v.Dim().isDirty = true
+	}
+	return offset
+}
+
+// ScrollBy scrolls given Viewporter v given number of canvas lines down
+// respectively up if n is negative, see [ScrollTo].  Returned is the
+// canvas line which is displayed first after scrolling.
+func ScrollBy(v Viewporter, n int) int {
+	vp := viewportOf(v)
+	if vp.height <= 0 {
+		return vp.offset
+	}
+	return ScrollTo(v, vp.offset+n)
+}
+
+// ScrollIntoView scrolls given Viewporter v minimally to have given
+// stacked Dimer d fully displayed if possible; otherwise d's first line
+// is displayed first.  Is d already displayed, not stacked by v or v
+// not laid out yet nothing happens.
+func ScrollIntoView(v Viewporter, d Dimer) {
+	vp := viewportOf(v)
+	if vp.height <= 0 {
+		return
+	}
+	top, idx := 0, -1
+	for i, _d := range vp.dd {
+		if _d == d {
+			idx = i
+			break
+		}
+		top += vp.hh[i]
+	}
+	if idx < 0 {
+		return
+	}
+	if top < vp.offset {
+		ScrollTo(v, top)
+		return
+	}
+	if bottom := top + vp.hh[idx]; bottom > vp.offset+vp.height {
+		if bottom-vp.height > top {
+			ScrollTo(v, top)
+			return
+		}
+		ScrollTo(v, bottom-vp.height)
+	}
+}
+
+// Canvas returns the height of given Viewporter v's virtual canvas and
+// the canvas line which is displayed first, i.e. the offset of v's
+// displayed part of the canvas.
+func Canvas(v Viewporter) (height, offset int) {
+	vp := viewportOf(v)
+	for _, h := range vp.hh {
+		height += h
+	}
+	return height, vp.offset
+}
+
+// Scrolled returns the canvas line which given Viewporter v displays
+// first and the greatest such line, i.e. the canvas line from which on
+// the rest of the canvas is fully displayed.
+func Scrolled(v Viewporter) (offset, max int) {
+	vp := viewportOf(v)
+	return vp.offset, vp.max
+}
+
+// viewport holds the stacked Dimers of a Viewporter together with
+// their heights on the virtual canvas, the height of the Viewporter's
+// area, the canvas line which is displayed first and the greatest such
+// line, i.e. the line from which on the rest of the canvas fits into
+// the Viewporter's area.
+type viewport struct {
+	dd                  []Dimer
+	hh                  []int
+	height, offset, max int
+}
+
+func viewportOf(v Viewporter) *viewport {
+	vp, canvas := &viewport{}, 0
+	_, _, _, vp.height = area(v)
+	v.ForStacked(func(d Dimer) (stop bool) {
+		h := canvasHeight(d)
+		vp.dd, vp.hh = append(vp.dd, d), append(vp.hh, h)
+		canvas += h
+		return false
+	})
+	if canvas > vp.height {
+		vp.max = canvas - vp.height
+	}
+	vp.offset = v.Offset()
+	if vp.offset > vp.max {
+		vp.offset = vp.max
+	}
+	if vp.offset < 0 {
+		vp.offset = 0
+	}
+	return vp
+}
+
+// canvasHeight returns the height of given stacked Dimer d on the
+// canvas of its Viewporter.
+func canvasHeight(d Dimer) int {
+	if d.Dim().fillsHeight > 0 {
+		return d.Dim().fillsHeight
+	}
+	return d.Dim().height
+}
+
+// layoutViewport lays out the stacked Dimers of given Viewporter v at
+// their canvas positions relative to given offset, i.e. the canvas
+// line which is displayed first.  Dimers which are not displayed are
+// put off-screen and a Dimer overflowing v's area at the bottom is
+// clipped while a Dimer overflowing at the top keeps its origin above
+// v's area, see [Viewporter].
+func layoutViewport(v Viewporter, offset int) {
+	x, y, width, height := area(v)
+	top := y - offset
+	v.ForStacked(func(d Dimer) (stop bool) {
+		h := canvasHeight(d)
+		defer func() { top += h }()
+		if top+h <= y || top >= y+height {
+			d.Dim().setOffScreen()
+			return false
+		}
+		d.Dim().setOrigin(x, top)
+		d.Dim().setLayedOutWidth(width, 0)
+		if top+h > y+height {
+			d.Dim().setLayedOutHeight(y+height-top, 0)
+			return false
+		}
+		d.Dim().setLayedOutHeight(h, 0)
+		return false
+	})
+}
@@ -0,0 +1,129 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package lyt
+
+import (
+	"testing"
+
+	. "github.com/slukits/gounit"
+)
+
+type viewporterFX struct {
+	stackerFX
+	offset int
+}
+
+func (v *viewporterFX) Offset() int { return v.offset }
+
+func (v *viewporterFX) SetOffset(o int) { v.offset = o }
+
+// scrollable returns a screen filling viewport fixture stacking given
+// number of Dimers with given fixed height.
+func scrollable(n, height int) *viewporterFX {
+	fx := &viewporterFX{stackerFX: stackerFX{Dimer: df.Screen()}}
+	for i := 0; i < n; i++ {
+		fx.dd = append(fx.dd, df.FixedH(height))
+	}
+	return fx
+}
+
+type viewporting struct{ Suite }
+
+func (s *viewporting) SetUp(t *T) { t.Parallel() }
+
+func (s *viewporting) Lays_out_stacked_starting_with_offset(t *T) {
+	fx := scrollable(5, 10)
+	fx.offset = 20
+	t.FatalOn(mf.ScreenOf(fx).Reflow(nil))
+	t.True(fx.dd[0].Dim().IsOffScreen())
+	t.True(fx.dd[1].Dim().IsOffScreen())
+	_, y, _, h := fx.dd[2].Dim().Screen()
+	t.True(y == 0 && h == 10)
+	_, y, _, h = fx.dd[4].Dim().Screen()
+	t.True(y == 20 && h == 5)
+}
+
+func (s *viewporting) Lays_out_stacked_partially_scrolled_out(t *T) {
+	fx := scrollable(5, 10)
+	fx.offset = 13
+	t.FatalOn(mf.ScreenOf(fx).Reflow(nil))
+	t.True(fx.dd[0].Dim().IsOffScreen())
+	_, y, _, h := fx.dd[1].Dim().Screen()
+	t.True(y == -3 && h == 10)
+	_, y, _, h = fx.dd[3].Dim().Screen()
+	t.True(y == 17 && h == 8)
+	t.True(fx.dd[4].Dim().IsOffScreen())
+}
+
+func (s *viewporting) Clamps_offset_to_fill_its_area(t *T) {
+	fx := scrollable(5, 10)
+	t.FatalOn(mf.ScreenOf(fx).Reflow(nil))
+	t.Eq(25, ScrollTo(fx, 40))
+	t.True(fx.Dim().IsDirty())
+	t.Eq(0, ScrollTo(fx, -1))
+
+	fx.offset = 40
+	t.FatalOn(mf.ScreenOf(fx).Reflow(nil))
+	_, y, _, h := fx.dd[2].Dim().Screen()
+	t.True(y == -5 && h == 10)
+	t.True(fx.dd[4].Dim().Y() == 15 && fx.dd[4].Dim().Height() == 10)
+}
+
+func (s *viewporting) Scrolls_line_wise(t *T) {
+	fx := scrollable(12, 5)
+	m := mf.ScreenOf(fx)
+	t.FatalOn(m.Reflow(nil))
+	t.Eq(23, ScrollBy(fx, 23))
+	t.FatalOn(m.Reflow(nil))
+	t.Eq(35, ScrollBy(fx, 23))
+	t.FatalOn(m.Reflow(nil))
+	t.Eq(12, ScrollBy(fx, -23))
+}
+
+func (s *viewporting) Scrolls_stacked_into_view(t *T) {
+	fx := scrollable(8, 5)
+	m := mf.ScreenOf(fx)
+	t.FatalOn(m.Reflow(nil))
+	ScrollIntoView(fx, fx.dd[6])
+	t.Eq(10, fx.offset)
+	t.FatalOn(m.Reflow(nil))
+	_, y, _, h := fx.dd[6].Dim().Screen()
+	t.True(y == 20 && h == 5)
+	ScrollIntoView(fx, fx.dd[1])
+	t.Eq(5, fx.offset)
+}
+
+func (s *viewporting) Scrolls_the_top_of_a_higher_stacked_into_view(
+	t *T,
+) {
+	fx := scrollable(3, 10)
+	fx.dd = append(fx.dd, df.FixedH(40))
+	m := mf.ScreenOf(fx)
+	t.FatalOn(m.Reflow(nil))
+	ScrollIntoView(fx, fx.dd[3])
+	t.Eq(30, fx.offset)
+	t.FatalOn(m.Reflow(nil))
+	_, y, _, h := fx.dd[3].Dim().Screen()
+	t.True(y == 0 && h == 25)
+	t.Eq(45, ScrollTo(fx, 50))
+	t.FatalOn(m.Reflow(nil))
+	_, y, _, h = fx.dd[3].Dim().Screen()
+	t.True(y == -15 && h == 40)
+}
+
+func (s *viewporting) Provides_its_canvas_height_and_offset(t *T) {
+	fx := scrollable(8, 5)
+	fx.offset = 10
+	t.FatalOn(mf.ScreenOf(fx).Reflow(nil))
+	height, offset := Canvas(fx)
+	t.True(height == 40 && offset == 10)
+	offset, max := Scrolled(fx)
+	t.True(offset == 10 && max == 15)
+}
+
+func TestViewporting(t *testing.T) {
+	t.Parallel()
+	Run(&viewporting{}, t)
+}
@@ -86,10 +86,9 @@ func NewFixture(t *testing.T, timeout time.Duration) (*UI, *Fixture) {
 // no-op if already a listener is set.
 func (tt *Fixture) Listen(l func(api.Eventer)) {
 	tt.t.Helper()
-	if tt.ui.listener != nil {
+	if !tt.ui.listen(l) {
 		return
 	}
-	tt.ui.listener = l
 	tt.PostResize(tstWidth, tstHeight)
 }
 
@@ -105,6 +104,13 @@ func (tt *Fixture) Display(s string, sty api.Style) {
 	}
 }
 
+// ClearScreen blanks all cells of the simulation screen.  Note
+// ClearScreen must be only called from within the event loop.
+func (tt *Fixture) ClearScreen() {
+	tt.ui.lib.Clear()
+	tt.ui.lib.Show()
+}
+
 func (tt *Fixture) PostKey(k api.Key, m api.ModifierMask) {
 	tt.t.Helper()
 	// NOTE UI.Post is used instead of tcell.PostEvent to make
@@ -269,16 +275,10 @@ func (tt *Fixture) PostResize(width, height int) {
 }
 
 func (tt *Fixture) Screen() api.StringScreen {
-	bld, screen := &strings.Builder{}, api.StringScreen{}
+	var screen api.StringScreen
 	err := tt.ui.Post(&screenEvent{when: time.Now(), grab: func() {
-		b, w, _ := tt.ui.lib.(tcell.SimulationScreen).GetContents()
-		for i, c := range b {
-			bld.WriteRune(c.Runes[0])
-			if (i+1)%w == 0 {
-				screen = append(screen, bld.String())
-				bld.Reset()
-			}
-		}
+		screen = stringScreen(
+			tt.ui.lib.(tcell.SimulationScreen).GetContents())
 	}})
 	if err != nil {
 		tt.t.Fatalf("testing: cells-are: screen-event: %v", err)
@@ -287,14 +287,10 @@ func (tt *Fixture) Screen() api.StringScreen {
 }
 
 func (tt *Fixture) ScreenArea(x, y, width, height int) api.StringScreen {
-	bld, screen := &strings.Builder{}, api.StringScreen{}
+	screen := api.StringScreen{}
 	err := tt.ui.Post(&screenEvent{when: time.Now(), grab: func() {
 		tt.screenArea(x, y, width, height, func(line []tcell.SimCell) {
-			for _, c := range line {
-				bld.WriteRune(c.Runes[0])
-			}
-			screen = append(screen, bld.String())
-			bld.Reset()
+			screen = append(screen, stringLine(line))
 		})
 	}})
 	if err != nil {
@@ -304,23 +300,10 @@ func (tt *Fixture) ScreenArea(x, y, width, height int) api.StringScreen {
 }
 
 func (tt *Fixture) Cells() api.CellsScreen {
-	cs, line := api.CellsScreen{api.CellsLine{}}, 0
+	var cs api.CellsScreen
 	err := tt.ui.Post(&screenEvent{when: time.Now(), grab: func() {
-		b, w, _ := tt.ui.lib.(tcell.SimulationScreen).GetContents()
-		if w == 0 {
-			cs = api.CellsScreen{}
-			return
-		}
-		styler := tcellToApiStyleClosure()
-		for i, c := range b {
-			cs[line] = append(cs[line], api.TestCell{
-				Rune: c.Runes[0], Style: styler(c.Style),
-			})
-			if (i+1)%w == 0 && i+1 < len(b) {
-				line++
-				cs = append(cs, api.CellsLine{})
-			}
-		}
+		cs = cellsScreen(
+			tt.ui.lib.(tcell.SimulationScreen).GetContents())
 	}})
 	if err != nil {
 		tt.t.Fatalf("testing: cells-are: screen-event: %v", err)
@@ -329,17 +312,10 @@ func (tt *Fixture) Cells() api.CellsScreen {
 }
 
 func (tt *Fixture) CellsArea(x, y, width, height int) api.CellsScreen {
-	cs, line := api.CellsScreen{}, -1
-	styler := tcellToApiStyleClosure()
+	cs, styler := api.CellsScreen{}, tcellToApiStyleClosure()
 	err := tt.ui.Post(&screenEvent{when: time.Now(), grab: func() {
 		tt.screenArea(x, y, width, height, func(l []tcell.SimCell) {
-			line++
-			cs = append(cs, api.CellsLine{})
-			for _, c := range l {
-				cs[line] = append(cs[line], api.TestCell{
-					Rune: c.Runes[0], Style: styler(c.Style),
-				})
-			}
+			cs = append(cs, cellsLine(l, styler))
 		})
 	}})
 	if err != nil {
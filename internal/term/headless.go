@@ -0,0 +1,210 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package term
+
+import (
+	"strings"
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/mattn/go-runewidth"
+	"github.com/slukits/lines/internal/api"
+)
+
+// NewHeadless returns an UI having an in-memory screen of given width
+// and height instead of a terminal screen.  The returned UI has the
+// same event-loop semantics as an UI returned by New but it doesn't
+// require a terminal.  Use the Listen method to set the listener which
+// is informed about reported events.
+func NewHeadless(width, height int) *UI {
+	lib := tcell.NewSimulationScreen("UTF-8")
+	ui := initUI(lib, nil, false)
+	lib.SetSize(width, height)
+	ui.lib.Clear()
+	ui.lib.Show()
+	return ui
+}
+
+// Sync blocks until all events posted before the Sync call have been
+// processed or the UI was quit.  Note Sync must not be called from
+// within the event loop, i.e. from within a listener.
+func (u *UI) Sync() { u.grab(func() {}) }
+
+// grab executes given function on the event loop after all events
+// posted before the grab call have been processed and blocks until it
+// was executed.  Has the UI been quit given function is executed
+// directly since there is no event loop anymore which could interfere.
+func (u *UI) grab(fn func()) {
+	if u.hasQuit.Load() {
+		fn()
+		return
+	}
+	done := make(chan struct{})
+	err := u.Post(&screenEvent{when: time.Now(), grab: func() {
+		fn()
+		close(done)
+	}})
+	if err != nil {
+		return
+	}
+	select {
+	case <-done:
+	case <-u.waitForQuit:
+	}
+}
+
+// PostKey posts a key event with given key k and modifiers m.
+func (u *UI) PostKey(k api.Key, m api.ModifierMask) error {
+	return u.Post(newKeyEvent(k, m))
+}
+
+// PostRune posts a rune event with given rune r and modifiers m.
+func (u *UI) PostRune(r rune, m api.ModifierMask) error {
+	return u.Post(newRuneEvent(r, m))
+}
+
+// PostMouse posts a mouse event at given coordinates with given buttons
+// b and modifiers m.
+func (u *UI) PostMouse(
+	x, y int, b api.ButtonMask, m api.ModifierMask,
+) error {
+	return u.Post(newMouseEvent(x, y, b, m))
+}
+
+// PostResize resizes an in-memory screen to given width and height and
+// posts a corresponding resize event.  The screen is resized on the
+// event loop, i.e. after all events posted before the resize were
+// processed.  PostResize is a no-op for a terminal screen.
+func (u *UI) PostResize(width, height int) error {
+	lib, ok := u.lib.(tcell.SimulationScreen)
+	if !ok {
+		return nil
+	}
+	err := u.Post(&screenEvent{when: time.Now(), grab: func() {
+		lib.SetSize(width, height)
+	}})
+	if err != nil {
+		return err
+	}
+	return u.Post(newResize(width, height))
+}
+
+// Screen returns a copy of the content of an in-memory screen as it was
+// made visible by the latest update or redraw.  Screen blocks until all
+// events posted before it have been processed and must not be called
+// from within a listener.  Screen returns nil for a terminal screen.
+func (u *UI) Screen() (s api.StringScreen) {
+	lib, ok := u.lib.(tcell.SimulationScreen)
+	if !ok {
+		return nil
+	}
+	u.grab(func() { s = stringScreen(lib.GetContents()) })
+	return s
+}
+
+// Cells returns a copy of the content of an in-memory screen along with
+// its styles as it was made visible by the latest update or redraw.
+// Cells blocks until all events posted before it have been processed
+// and must not be called from within a listener.  Cells returns nil for
+// a terminal screen.
+func (u *UI) Cells() (cs api.CellsScreen) {
+	lib, ok := u.lib.(tcell.SimulationScreen)
+	if !ok {
+		return nil
+	}
+	u.grab(func() { cs = cellsScreen(lib.GetContents()) })
+	return cs
+}
+
+// simRune returns the displayed rune of given simulation cell c.
+func simRune(c tcell.SimCell) rune {
+	if len(c.Runes) == 0 {
+		return ' '
+	}
+	return c.Runes[0]
+}
+
+// simCombining returns the combining runes displayed together with the
+// rune of given simulation cell c or nil if there are none.
+func simCombining(c tcell.SimCell) []rune {
+	if len(c.Runes) < 2 {
+		return nil
+	}
+	return c.Runes[1:]
+}
+
+// isWide returns true if given simulation cell c displays a wide rune
+// respectively grapheme cluster which takes two screen cells, i.e. its
+// first rune is wide as tcell sizes a cell by its first rune.
+func isWide(c tcell.SimCell) bool {
+	return len(c.Runes) > 0 && runewidth.RuneWidth(c.Runes[0]) >= 2
+}
+
+// stringLine converts given simulation cells cc of a screen line into a
+// string whereas the second cell of a wide rune is skipped, i.e. the
+// string has the runes as they are perceived on the screen.
+func stringLine(cc []tcell.SimCell) string {
+	bld := &strings.Builder{}
+	for i := 0; i < len(cc); i++ {
+		bld.WriteRune(simRune(cc[i]))
+		for _, r := range simCombining(cc[i]) {
+			bld.WriteRune(r)
+		}
+		if isWide(cc[i]) {
+			i++
+		}
+	}
+	return bld.String()
+}
+
+// cellsLine converts given simulation cells cc of a screen line into a
+// cells line whereas the second cell of a wide rune is a space having
+// the wide rune's style.
+func cellsLine(
+	cc []tcell.SimCell, styler func(tcell.Style) api.Style,
+) api.CellsLine {
+	line, second := api.CellsLine{}, false
+	for _, c := range cc {
+		if second {
+			line = append(line, api.TestCell{
+				Rune: ' ', Style: line[len(line)-1].Style})
+			second = false
+			continue
+		}
+		line = append(line, api.TestCell{Rune: simRune(c),
+			Combining: simCombining(c), Style: styler(c.Style)})
+		second = isWide(c)
+	}
+	return line
+}
+
+// stringScreen converts given simulation cells bb of a screen having
+// given width into a string screen.
+func stringScreen(bb []tcell.SimCell, width, _ int) api.StringScreen {
+	screen := api.StringScreen{}
+	if width == 0 {
+		return screen
+	}
+	for i := 0; i+width <= len(bb); i += width {
+		screen = append(screen, stringLine(bb[i:i+width]))
+	}
+	return screen
+}
+
+// cellsScreen converts given simulation cells bb of a screen having
+// given width into a cells screen.
+func cellsScreen(bb []tcell.SimCell, width, _ int) api.CellsScreen {
+	if width == 0 {
+		return api.CellsScreen{}
+	}
+	cs, styler := api.CellsScreen{}, tcellToApiStyleClosure()
+	for i := 0; i+width <= len(bb); i += width {
+		cs = append(cs, cellsLine(bb[i:i+width], styler))
+	}
+	if len(cs) == 0 {
+		cs = append(cs, api.CellsLine{})
+	}
+	return cs
+}
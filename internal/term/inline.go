@@ -0,0 +1,210 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package term
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/gdamore/tcell/v2/terminfo"
+	"github.com/slukits/lines/internal/api"
+)
+
+// cursorQueryTimeout is the duration an inline ui waits for the
+// terminal's answer to a cursor position query.
+var cursorQueryTimeout = 500 * time.Millisecond
+
+// NewInline returns an UI which doesn't take over the whole terminal
+// screen but displays in given number of lines starting at the line of
+// the terminal's cursor.  The terminal is scrolled if there are not
+// enough lines below the cursor.  The final frame is left in the
+// terminal's scrollback after quitting.  NewInline panics if the
+// terminal can't be obtained.
+func NewInline(listener func(api.Eventer), height int) *UI {
+	tty, err := tcell.NewDevTty()
+	if err != nil {
+		panic(fmt.Sprintf(
+			"lines: term: new inline: can't obtain tty: %v", err))
+	}
+	ti, err := tcell.LookupTerminfo(os.Getenv("TERM"))
+	if err != nil {
+		panic(fmt.Sprintf(
+			"lines: term: new inline: can't obtain terminfo: %v", err))
+	}
+	return newInline(tty, ti, listener, height)
+}
+
+func newInline(
+	tty tcell.Tty, ti *terminfo.Terminfo, l func(api.Eventer), height int,
+) *UI {
+	it := newInlineTty(tty, ti, height)
+	lib, err := tcell.NewTerminfoScreenFromTtyTerminfo(it, it.ti)
+	if err != nil {
+		panic(fmt.Sprintf(
+			"lines: term: new inline: can't obtain screen: %v", err))
+	}
+	ui := setupUI(lib, l, false)
+	ui.inline = it
+	go ui.poll()
+	return ui
+}
+
+// inlineTty wraps a terminal's tty to restrict the screen tcell is
+// drawing on to the reserved lines of an inline ui.  tcell uses
+// absolute cursor addressing hence the reserved lines are realized by
+// a terminfo copy whose cursor addressing is offset by the reserved
+// lines' top row and which neither switches to the alternate screen
+// nor clears the terminal.
+type inlineTty struct {
+	tcell.Tty
+
+	// ti is the modified terminfo copy used by tcell.
+	ti *terminfo.Terminfo
+
+	// cup is the original cursor addressing of the terminal.
+	cup string
+
+	// height is the number of requested lines.
+	height int
+
+	// top is the terminal row of the first reserved line.
+	top atomic.Int32
+}
+
+func newInlineTty(
+	tty tcell.Tty, ti *terminfo.Terminfo, height int,
+) *inlineTty {
+	if height < 1 {
+		height = 1
+	}
+	cp := *ti
+	cp.EnterCA, cp.ExitCA, cp.Clear = "", "", ""
+	return &inlineTty{Tty: tty, ti: &cp, cup: ti.SetCursor,
+		height: height}
+}
+
+// WindowSize reports the terminal's width and the number of reserved
+// lines as screen size.
+func (t *inlineTty) WindowSize() (int, int, error) {
+	w, h, err := t.Tty.WindowSize()
+	if err != nil {
+		return w, h, err
+	}
+	return w, t.lines(h), nil
+}
+
+func (t *inlineTty) lines(termHeight int) int {
+	if t.height > termHeight {
+		return termHeight
+	}
+	return t.height
+}
+
+// Start starts the wrapped tty and reserves the requested lines
+// starting at the terminal cursor's row.  Since Start is also called
+// on resuming a suspended ui the lines are reserved anew below the
+// output of a program which was run during the suspension.
+func (t *inlineTty) Start() error {
+	if err := t.Tty.Start(); err != nil {
+		return err
+	}
+	_, h, err := t.Tty.WindowSize()
+	if err != nil {
+		return err
+	}
+	height := t.lines(h)
+	fmt.Fprint(t.Tty, "\r"+strings.Repeat("\n", height-1))
+	row, err := t.cursorRow()
+	if err != nil {
+		row = h - 1
+	}
+	top := row - (height - 1)
+	if top < 0 {
+		top = 0
+	}
+	t.top.Store(int32(top))
+	t.ti.SetCursor = strings.Replace(
+		t.cup, "%p1", fmt.Sprintf("%%p1%%{%d}%%+", top), 1)
+	return nil
+}
+
+// Stop moves the cursor below the reserved lines which leaves the last
+// displayed frame in the terminal's scrollback before the wrapped tty
+// is stopped.
+func (t *inlineTty) Stop() error {
+	_, h, err := t.Tty.WindowSize()
+	if err == nil {
+		fmt.Fprint(t.Tty, t.ti.TGoto(0, t.lines(h)-1)+"\r\n")
+	}
+	return t.Tty.Stop()
+}
+
+// cursorRow queries the terminal for the zero based row of its cursor.
+func (t *inlineTty) cursorRow() (int, error) {
+	if _, err := fmt.Fprint(t.Tty, "\x1b[6n"); err != nil {
+		return 0, err
+	}
+	answer := make(chan int, 1)
+	go func() {
+		row, bb, b := 0, []byte{}, make([]byte, 1)
+		for {
+			if n, err := t.Tty.Read(b); err != nil || n == 0 {
+				close(answer)
+				return
+			}
+			bb = append(bb, b[0])
+			if b[0] != 'R' {
+				continue
+			}
+			i := strings.LastIndex(string(bb), "\x1b[")
+			if i < 0 {
+				bb = bb[:0]
+				continue
+			}
+			var col int
+			_, err := fmt.Sscanf(
+				string(bb[i:]), "\x1b[%d;%dR", &row, &col)
+			if err != nil {
+				bb = bb[:0]
+				continue
+			}
+			answer <- row - 1
+			return
+		}
+	}()
+	select {
+	case row, ok := <-answer:
+		if !ok {
+			return 0, errors.New("lines: term: inline: no cursor position")
+		}
+		return row, nil
+	case <-time.After(cursorQueryTimeout):
+		// unblock the pending read and reset the tty
+		t.Tty.Drain()
+		<-answer
+		t.Tty.Stop()
+		if err := t.Tty.Start(); err != nil {
+			return 0, err
+		}
+		return 0, errors.New("lines: term: inline: cursor query timeout")
+	}
+}
+
+// mouse translates given mouse event's terminal coordinates into
+// coordinates of the reserved lines.  mouse returns nil if the event
+// happened outside the reserved lines.
+func (t *inlineTty) mouse(evt *tcell.EventMouse) *tcell.EventMouse {
+	x, y := evt.Position()
+	y -= int(t.top.Load())
+	if y < 0 || y >= t.height {
+		return nil
+	}
+	return tcell.NewEventMouse(x, y, evt.Buttons(), evt.Modifiers())
+}
@@ -0,0 +1,170 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package term
+
+import (
+	"io"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/gdamore/tcell/v2"
+	. "github.com/slukits/gounit"
+	"github.com/slukits/lines/internal/api"
+)
+
+// ttyFX is a tcell.Tty implementation recording the output and
+// answering cursor position queries with its row.
+type ttyFX struct {
+	mutex         sync.Mutex
+	out           strings.Builder
+	in            chan []byte
+	pending       []byte
+	drained       chan struct{}
+	width, height int
+	row           string
+}
+
+func newTtyFX(width, height int, row string) *ttyFX {
+	return &ttyFX{in: make(chan []byte, 8), drained: make(chan struct{}),
+		width: width, height: height, row: row}
+}
+
+func (tty *ttyFX) Start() error {
+	tty.mutex.Lock()
+	defer tty.mutex.Unlock()
+	tty.drained = make(chan struct{})
+	return nil
+}
+
+func (tty *ttyFX) Stop() error { return nil }
+
+func (tty *ttyFX) Drain() error {
+	tty.mutex.Lock()
+	defer tty.mutex.Unlock()
+	close(tty.drained)
+	return nil
+}
+
+func (tty *ttyFX) NotifyResize(func()) {}
+
+func (tty *ttyFX) WindowSize() (int, int, error) {
+	return tty.width, tty.height, nil
+}
+
+func (tty *ttyFX) Read(bb []byte) (int, error) {
+	tty.mutex.Lock()
+	drained := tty.drained
+	tty.mutex.Unlock()
+	if len(tty.pending) == 0 {
+		select {
+		case tty.pending = <-tty.in:
+		case <-drained:
+			return 0, io.EOF
+		}
+	}
+	n := copy(bb, tty.pending)
+	tty.pending = tty.pending[n:]
+	return n, nil
+}
+
+func (tty *ttyFX) Write(bb []byte) (int, error) {
+	tty.mutex.Lock()
+	defer tty.mutex.Unlock()
+	if strings.Contains(string(bb), "\x1b[6n") && tty.row != "" {
+		tty.in <- []byte("\x1b[" + tty.row + ";1R")
+	}
+	return tty.out.Write(bb)
+}
+
+func (tty *ttyFX) Close() error { return nil }
+
+func (tty *ttyFX) String() string {
+	tty.mutex.Lock()
+	defer tty.mutex.Unlock()
+	return tty.out.String()
+}
+
+type inline struct{ Suite }
+
+func (s *inline) SetUp(t *T) { t.Parallel() }
+
+func (s *inline) ui(t *T, tty *ttyFX, height int) (*UI, chan api.Eventer) {
+	ti, err := tcell.LookupTerminfo("xterm")
+	t.FatalOn(err)
+	ee := make(chan api.Eventer, 8)
+	ui := newInline(tty, ti, func(e api.Eventer) { ee <- e }, height)
+	t.GoT().Cleanup(ui.Quit)
+	return ui, ee
+}
+
+func (s *inline) Reserves_requested_lines_as_screen(t *T) {
+	ui, _ := s.ui(t, newTtyFX(80, 24, "10"), 5)
+	width, height := ui.Size()
+	t.Eq(80, width)
+	t.Eq(5, height)
+}
+
+func (s *inline) Reserves_at_most_the_terminal_s_lines(t *T) {
+	ui, _ := s.ui(t, newTtyFX(80, 4, "4"), 5)
+	_, height := ui.Size()
+	t.Eq(4, height)
+}
+
+func (s *inline) Scrolls_the_terminal_to_have_requested_lines(t *T) {
+	tty := newTtyFX(80, 24, "24")
+	s.ui(t, tty, 5)
+	t.True(strings.HasPrefix(tty.String(), "\r\n\n\n\n\x1b[6n"))
+}
+
+func (s *inline) Displays_relative_to_reserved_lines(t *T) {
+	tty := newTtyFX(80, 24, "10") // reserved lines are rows 5 to 9
+	ui, _ := s.ui(t, tty, 5)
+	ui.Display(0, 1, 'x', ui.NewStyle())
+	ui.Update()
+	t.Contains(tty.String(), "\x1b[6;1H")
+	t.Contains(tty.String(), "\x1b[10;1H")
+	t.Not.Contains(tty.String(), "\x1b[5;1H")
+	t.Not.Contains(tty.String(), "\x1b[11;1H")
+	t.Not.Contains(tty.String(), "\x1b[?1049h")
+	t.Not.Contains(tty.String(), "\x1b[H\x1b[2J")
+}
+
+func (s *inline) Falls_back_to_the_bottom_without_cursor_answer(t *T) {
+	tty := newTtyFX(80, 24, "")
+	ui, _ := s.ui(t, tty, 5)
+	ui.Display(0, 0, 'x', ui.NewStyle())
+	ui.Update()
+	t.Contains(tty.String(), "\x1b[20;1H")
+	t.Not.Contains(tty.String(), "\x1b[19;1H")
+}
+
+func (s *inline) Reports_mouse_events_relative_to_reserved_lines(t *T) {
+	ui, ee := s.ui(t, newTtyFX(80, 24, "10"), 5)
+	t.FatalOn(ui.lib.PostEvent(tcell.NewEventMouse(
+		2, 6, tcell.Button1, tcell.ModNone)))
+	for e := range ee {
+		me, ok := e.(api.MouseEventer)
+		if !ok {
+			continue
+		}
+		x, y := me.Pos()
+		t.True(x == 2 && y == 1)
+		break
+	}
+}
+
+func (s *inline) Leaves_the_cursor_below_reserved_lines_on_quit(t *T) {
+	tty := newTtyFX(80, 24, "10")
+	ui, _ := s.ui(t, tty, 5)
+	ui.Quit()
+	ui.WaitForQuit()
+	t.Contains(tty.String(), "\x1b[10;1H\r\n")
+}
+
+func TestInline(t *testing.T) {
+	t.Parallel()
+	Run(&inline{}, t)
+}
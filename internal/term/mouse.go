@@ -95,99 +95,11 @@ func (e *mouseEvent) When() time.Time { return e.evt.When() }
 
 func (e *mouseEvent) Source() interface{} { return e.evt }
 
+// mouseAggregator adapts an api mouse aggregator to tcell mouse
+// events.
 func mouseAggregator() func(e *tcell.EventMouse) api.MouseEventer {
-
-	var last *tcell.EventMouse
-	inDrag, ox, oy, firstMove := false, 0, 0, true
-
-	var clear = func(e *tcell.EventMouse) {
-		last = nil
-		ox, oy = e.Position()
-		if inDrag {
-			inDrag = false
-		}
-	}
-
-	var eqBB = func(exp tcell.ButtonMask, ee ...*tcell.EventMouse) bool {
-		for _, e := range ee {
-			if exp == e.Buttons() {
-				continue
-			}
-			return false
-		}
-		return true
-	}
-
-	var eqPos = func(
-		e, other *tcell.EventMouse, ee ...*tcell.EventMouse,
-	) bool {
-		x, y := e.Position()
-		ox, oy := other.Position()
-		for _, _e := range ee {
-			_x, _y := _e.Position()
-			if x == _x && y == _y {
-				continue
-			}
-			return false
-		}
-		return x == ox && y == oy
-	}
-
-	var zeroEvt = func(e, other *tcell.EventMouse) bool {
-		return e.Buttons() == other.Buttons() && eqPos(e, other)
-	}
-
-	var zeroBtt = func(e *tcell.EventMouse) bool {
-		return e.Buttons() == tcell.ButtonNone
-	}
-
-	return func(e *tcell.EventMouse) (evt api.MouseEventer) {
-		switchFirstMove := func() {
-			if firstMove {
-				firstMove = false
-			}
-		}
-		switch last {
-		case nil:
-			if e.Buttons() == tcell.ButtonNone {
-				// ignore zero-button without movement
-				x, y := e.Position()
-				if ox == x && oy == y && !firstMove {
-					return
-				}
-				switchFirstMove()
-				evt = api.NewMouseMove(ox, oy, &mouseEvent{evt: e})
-				clear(e)
-				return evt
-			}
-			last = e
-			ox, oy = e.Position()
-			switchFirstMove()
-			return nil
-		default:
-			switchFirstMove()
-			if zeroEvt(last, e) {
-				return nil
-			}
-			if eqBB(last.Buttons(), e) {
-				if !inDrag {
-					inDrag = true
-				}
-				last = e
-				return api.NewMouseDrag(ox, oy, &mouseEvent{evt: e})
-			}
-			if inDrag {
-				inDrag = false
-				evt = api.NewMouseDrop(&mouseEvent{evt: last})
-			} else {
-				evt = api.NewMouseClick(&mouseEvent{evt: last})
-			}
-			if zeroBtt(e) {
-				clear(e)
-				return evt
-			}
-			last = e
-			return evt
-		}
+	aggregate := api.NewMouseAggregator()
+	return func(e *tcell.EventMouse) api.MouseEventer {
+		return aggregate(&mouseEvent{evt: e})
 	}
 }
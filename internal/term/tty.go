@@ -0,0 +1,214 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package term
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sync"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/gdamore/tcell/v2/terminfo"
+	"github.com/slukits/lines/internal/api"
+	"golang.org/x/term"
+)
+
+// NewOn returns an UI running on given tty instead of the process's
+// terminal whose capabilities are looked up for given terminal name,
+// e.g. the TERM of a ssh-session.  An empty name defaults to the TERM
+// environment variable.  Many UIs created by NewOn may coexist in one
+// process, each having its own event-loop.  NewOn panics if the
+// terminal name is unknown or the screen can't be obtained.
+func NewOn(tty tcell.Tty, name string, listener func(api.Eventer)) *UI {
+	var ti *terminfo.Terminfo
+	if name != "" {
+		var err error
+		if ti, err = tcell.LookupTerminfo(name); err != nil {
+			panic(fmt.Sprintf(
+				"lines: term: new on: unknown terminal %q: %v", name, err))
+		}
+	}
+	lib, err := tcell.NewTerminfoScreenFromTtyTerminfo(tty, ti)
+	if err != nil {
+		panic(fmt.Sprintf(
+			"lines: term: new on: can't obtain screen: %v", err))
+	}
+	return initUI(lib, listener, false)
+}
+
+// WindowSize is the size of a terminal window in cells.
+type WindowSize struct{ Width, Height int }
+
+// NewTty returns a tcell.Tty reading from and writing to given
+// read-writer rw.  Is rw a terminal file it is put into raw mode while
+// the tty is started.  The tty's window size is the latest size
+// received from given channel sizes.  Has no size been received yet
+// the size of a terminal file or, if not available, 80x25 is reported.
+// Note the returned tty doesn't close rw.
+func NewTty(rw io.ReadWriter, sizes <-chan WindowSize) tcell.Tty {
+	t := &tty{rw: rw, sizes: sizes, input: make(chan []byte),
+		closed: make(chan struct{})}
+	if f, ok := rw.(*os.File); ok && term.IsTerminal(int(f.Fd())) {
+		t.fd = int(f.Fd())
+		t.isTerminal = true
+	}
+	return t
+}
+
+// tty implements tcell.Tty for an arbitrary read-writer.  Since a
+// blocking read on a read-writer can't be interrupted a pump go-routine
+// reads from the read-writer which allows a tty to drain its input.
+type tty struct {
+	rw         io.ReadWriter
+	fd         int
+	isTerminal bool
+	saved      *term.State
+
+	// input provides the pumped input; it is closed on a read error.
+	input   chan []byte
+	readErr error
+	pending []byte
+	pumping sync.Once
+	closed  chan struct{}
+	closing sync.Once
+
+	sizes <-chan WindowSize
+
+	mutex   sync.Mutex
+	size    *WindowSize
+	resized func()
+	drained chan struct{}
+	stop    chan struct{}
+}
+
+func (t *tty) Start() error {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	if t.isTerminal {
+		saved, err := term.MakeRaw(t.fd)
+		if err != nil {
+			return err
+		}
+		t.saved = saved
+	}
+	t.drained, t.stop = make(chan struct{}), make(chan struct{})
+	t.pumping.Do(func() { go t.pump() })
+	go t.watch(t.stop)
+	return nil
+}
+
+// pump reads from the wrapped read-writer until it fails.
+func (t *tty) pump() {
+	for {
+		bb := make([]byte, 128)
+		n, err := t.rw.Read(bb)
+		if n > 0 {
+			select {
+			case t.input <- bb[:n]:
+			case <-t.closed:
+				return
+			}
+		}
+		if err != nil {
+			t.readErr = err
+			close(t.input)
+			return
+		}
+	}
+}
+
+// watch updates the window size from received sizes until given stop
+// channel is closed.
+func (t *tty) watch(stop chan struct{}) {
+	for {
+		select {
+		case ws, ok := <-t.sizes:
+			if !ok {
+				return
+			}
+			t.mutex.Lock()
+			t.size = &ws
+			resized := t.resized
+			t.mutex.Unlock()
+			if resized != nil {
+				resized()
+			}
+		case <-stop:
+			return
+		}
+	}
+}
+
+func (t *tty) Stop() error {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	close(t.stop)
+	if t.isTerminal && t.saved != nil {
+		return term.Restore(t.fd, t.saved)
+	}
+	return nil
+}
+
+func (t *tty) Drain() error {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	select {
+	case <-t.drained:
+	default:
+		close(t.drained)
+	}
+	return nil
+}
+
+func (t *tty) NotifyResize(cb func()) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.resized = cb
+}
+
+func (t *tty) WindowSize() (int, int, error) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	if t.size != nil {
+		return t.size.Width, t.size.Height, nil
+	}
+	if t.isTerminal {
+		w, h, err := term.GetSize(t.fd)
+		if err != nil || w > 0 && h > 0 {
+			return w, h, err
+		}
+	}
+	return 80, 25, nil
+}
+
+func (t *tty) Read(bb []byte) (int, error) {
+	if len(t.pending) == 0 {
+		t.mutex.Lock()
+		drained := t.drained
+		t.mutex.Unlock()
+		select {
+		case input, ok := <-t.input:
+			if !ok {
+				return 0, t.readErr
+			}
+			t.pending = input
+		case <-drained:
+			return 0, io.EOF
+		}
+	}
+	n := copy(bb, t.pending)
+	t.pending = t.pending[n:]
+	return n, nil
+}
+
+func (t *tty) Write(bb []byte) (int, error) { return t.rw.Write(bb) }
+
+// Close stops the pumping of input but doesn't close the wrapped
+// read-writer which is owned by the creator of the tty.
+func (t *tty) Close() error {
+	t.closing.Do(func() { close(t.closed) })
+	return nil
+}
@@ -23,7 +23,7 @@ import (
 type UI struct {
 
 	// listener is informed about new events.
-	listener func(api.Eventer)
+	listener atomic.Pointer[func(api.Eventer)]
 
 	// lib the tcell terminal screen which is the simulation screen in
 	// case of testing
@@ -51,6 +51,10 @@ type UI struct {
 	// mouseAggregate is a closure receiving tcell mouse events as they
 	// come in and provides aggregations if any.
 	mouseAggregate func(*tcell.EventMouse) api.MouseEventer
+
+	// inline is set for an inline ui displaying only in reserved lines
+	// of a terminal, see NewInline.
+	inline *inlineTty
 }
 
 func New(listener func(api.Eventer)) *UI {
@@ -65,6 +69,14 @@ func New(listener func(api.Eventer)) *UI {
 func (u *UI) Lib() interface{} { return u.lib }
 
 func initUI(lib tcell.Screen, l func(api.Eventer), gpm bool) *UI {
+	ui := setupUI(lib, l, gpm)
+	go ui.poll()
+	return ui
+}
+
+// setupUI initializes given tcell screen lib and returns an UI wrapping
+// it whose event polling is not started yet.
+func setupUI(lib tcell.Screen, l func(api.Eventer), gpm bool) *UI {
 	if err := lib.Init(); err != nil {
 		panic(fmt.Sprintf(
 			"lines: term: new: can't obtain screen: %v", err))
@@ -83,13 +95,34 @@ func initUI(lib tcell.Screen, l func(api.Eventer), gpm bool) *UI {
 		defaultStyle:   api.DefaultStyle,
 		styler:         apiToTcellStyleClosure(),
 		waitForQuit:    make(chan struct{}),
-		listener:       l,
 		mouseAggregate: mouseAggregator(),
 	}
-	go ui.poll()
+	if l != nil {
+		ui.listener.Store(&l)
+	}
 	return ui
 }
 
+// Listen sets given listener l which is informed about all subsequently
+// reported events and posts a resize event reporting the current screen
+// size.  Listen is a no-op if l is nil or a listener is already set.
+func (u *UI) Listen(l func(api.Eventer)) {
+	if !u.listen(l) {
+		return
+	}
+	width, height := u.Size()
+	u.Post(newResize(width, height))
+}
+
+// listen sets given listener l and returns true iff l is not nil and
+// there was no listener set yet.
+func (u *UI) listen(l func(api.Eventer)) bool {
+	if l == nil {
+		return false
+	}
+	return u.listener.CompareAndSwap(nil, &l)
+}
+
 // WaitForQuit returns a channel which is closed if the event-loop is
 // quit.
 func (u *UI) WaitForQuit() {
@@ -147,6 +180,15 @@ type quitEvent struct {
 func (e *quitEvent) When() time.Time     { return e.when }
 func (e *quitEvent) Source() interface{} { return e }
 
+// Suspend stops the terminal's input and output processing and
+// restores the terminal settings from before the ui was initialized,
+// i.e. the terminal is in cooked mode.
+func (u *UI) Suspend() error { return u.lib.Suspend() }
+
+// Resume puts the terminal back into raw mode and resumes the input and
+// output processing after a Suspend call.
+func (u *UI) Resume() error { return u.lib.Resume() }
+
 // Colors provide the number of available (ANSI) colors.  In case of
 // a monochrome screen 0 is returned.
 func (u *UI) Colors() int {
@@ -172,9 +214,9 @@ func (u *UI) poll() {
 		if evt == nil {
 			return
 		}
-		lst := u.listener
-		if lst == nil {
-			lst = func(e api.Eventer) {}
+		lst := func(e api.Eventer) {}
+		if l := u.listener.Load(); l != nil {
+			lst = *l
 		}
 		switch evt := evt.(type) {
 		case *tcell.EventResize:
@@ -186,6 +228,11 @@ func (u *UI) poll() {
 			}
 			lst(&keyEvent{evt: evt})
 		case *tcell.EventMouse:
+			if u.inline != nil {
+				if evt = u.inline.mouse(evt); evt == nil {
+					break
+				}
+			}
 			lst(&mouseEvent{evt: evt})
 			if e := u.mouseAggregate(evt); e != nil {
 				lst(e)
@@ -271,6 +318,16 @@ func (u *UI) Display(x, y int, r rune, s api.Style) {
 	u.lib.SetContent(x, y, r, nil, u.styler(s))
 }
 
+// DisplayCluster sets at given screen coordinates (x,y) given grapheme
+// cluster rr, i.e. its first rune and its combining runes, with given
+// style s.
+func (u *UI) DisplayCluster(x, y int, rr []rune, s api.Style) {
+	if len(rr) == 0 {
+		return
+	}
+	u.lib.SetContent(x, y, rr[0], rr[1:], u.styler(s))
+}
+
 // Redraw blank all cells and draw the screen content.
 func (u *UI) Redraw() { u.lib.Sync() }
 
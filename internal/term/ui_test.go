@@ -148,6 +148,16 @@ func (s *AnUI) Displays_given_rune_at_given_position(t *T) {
 	t.Eq("x", tt.ScreenArea(1, 1, 1, 1).String())
 }
 
+func (s *AnUI) Displays_given_cluster_at_given_position(t *T) {
+	ui, tt := LstFixture(t.GoT(), nil, 0)
+	tt.PostResize(3, 3)
+
+	ui.DisplayCluster(1, 1, []rune("e\u0301"), ui.NewStyle())
+	ui.Redraw()
+	t.Eq("e\u0301", tt.ScreenArea(1, 1, 1, 1).String())
+	t.Eq([]rune{'\u0301'}, tt.Cells()[1][1].Combining)
+}
+
 func TestAnUI(t *testing.T) {
 	t.Parallel()
 	Run(&AnUI{}, t)
@@ -44,7 +44,7 @@ type Line struct {
 	// fillAt indicates line filling runes and reset by a call of
 	// reset().
 	fillAt []int
-	// start is the first rune index of the displayed line content rr.
+	// start is the first screen cell of the displayed line content rr.
 	// start is reset if reset() or resetLineFocus() is called.
 	start int
 	// ofRight stores a line's overflow at the right side since it was
@@ -55,15 +55,20 @@ type Line struct {
 	// asked for overflowing (see isOverflowing) the last time.  ofLeft
 	// is reset if reset() or resetLineFocus() is called.
 	ofLeft bool
+	// wrapped caches a line's row spans for the width it was wrapped
+	// at, see rows.  wrapped is reset if the line is flagged dirty.
+	wrapped *rowSpans
 }
 
+// Len returns the number of screen cells of given line l's content
+// which is its number of runes unless it has wide runes.
 func (l *Line) Len() int {
-	return len(l.rr)
+	return l.cells()
 }
 
 func (l *Line) isOverflowing(width int) (left, right, changed bool) {
 	left = l.start > 0
-	right = len(l.rr)-l.start > width
+	right = l.cells()-l.start > width
 	if left == l.ofLeft && right == l.ofRight {
 		return left, right, false
 	}
@@ -138,31 +143,34 @@ func cleanForFlagging(ff LineFlags) LineFlags {
 	return ff
 }
 
-// incrementStart moves overflowing content one rune to the left for the
-// use case that the cursor is in a component's last content column and
-// the user goes to the right.  incrementStart is a no-op if there are
-// no overflowing runes.
-func (l *Line) incrementStart(width int) {
-	if len(l.rr) == 0 || len(l.rr[l.start:]) <= width {
+// incrementStart moves overflowing content given number of cells to
+// the left for the use case that the cursor is in a component's last
+// content column and the user goes to the right.  incrementStart is a
+// no-op if there are no overflowing runes.
+func (l *Line) incrementStart(width, by int) {
+	if len(l.rr) == 0 || l.cells()-l.start <= width {
 		return
 	}
-	l.start++
+	l.start += by
 	l.setDirty()
 }
 
-func (l *Line) decrementStart() {
+func (l *Line) decrementStart(by int) {
 	if l.start == 0 {
 		return
 	}
-	l.start--
+	l.start -= by
+	if l.start < 0 {
+		l.start = 0
+	}
 	l.setDirty()
 }
 
 func (l *Line) moveStartToEnd(width int) {
-	if len(l.rr) == 0 || len(l.rr[l.start:]) < width {
+	if len(l.rr) == 0 || l.cells()-l.start < width {
 		return
 	}
-	l.start = len(l.rr) - width
+	l.start = l.cells() - width
 	l.setDirty()
 }
 
@@ -175,8 +183,10 @@ func (l *Line) resetLineFocus() {
 	l.setDirty()
 }
 
-// setDirty sets the dirty flag if not set.
+// setDirty sets the dirty flag if not set and resets the line's cached
+// rows.
 func (l *Line) setDirty() {
+	l.wrapped = nil
 	if l.isDirty() {
 		return
 	}
@@ -267,10 +277,19 @@ func (l *Line) setStyled(s string, sty Style) {
 	l.setDirty()
 }
 
-// setAt sets given rune slice rr at given position p in given line l's
-// content overwriting what has been at and after this position.  If
-// needed rr is padded with spaces to p.
+// setAt sets given rune slice rr at given cell p in given line l's
+// content overwriting what has been at and after this cell.  If needed
+// rr is padded with spaces to p.
 func (l *Line) setAt(p int, rr []rune) {
+	if p >= 0 {
+		p = l.atCell(p)
+	}
+	l.setRunesAt(p, rr)
+}
+
+// setRunesAt sets given rune slice rr at given rune index p in given
+// line l's content, see setAt.
+func (l *Line) setRunesAt(p int, rr []rune) {
 	if p < -1 {
 		return
 	}
@@ -315,12 +334,15 @@ func (l *Line) truncateAt(p int) {
 	}
 }
 
-// setStyledAt sets given rune slice rr at given position p in given
-// line l's content overwriting what has been at and after this position
-// and adds a corresponding style range.  If needed l's content is
-// padded with spaces until p.
+// setStyledAt sets given rune slice rr at given cell at in given line
+// l's content overwriting what has been at and after this cell and
+// adds a corresponding style range.  If needed l's content is padded
+// with spaces until at.
 func (l *Line) setStyledAt(at int, rr []rune, sty Style) {
-	l.setAt(at, rr)
+	if at >= 0 {
+		at = l.atCell(at)
+	}
+	l.setRunesAt(at, rr)
 	if at == -1 {
 		l.setDefaultStyle(sty)
 		return
@@ -331,9 +353,15 @@ func (l *Line) setStyledAt(at int, rr []rune, sty Style) {
 	l.ss[Range{at, at + len(rr)}] = sty
 }
 
-// setAtFilling sets given rune r at given position p of given line l's
+// setAtFilling sets given rune r at given cell p of given line l's
 // content truncating all possibly following content.
 func (l *Line) setAtFilling(p int, r rune) {
+	l.setRunesAtFilling(l.atCell(p), r)
+}
+
+// setRunesAtFilling sets given rune r at given rune index p of given
+// line l's content, see setAtFilling.
+func (l *Line) setRunesAtFilling(p int, r rune) {
 	l.padTo(p)
 	l.truncateAt(p)
 	l.rr = append(l.rr[:p], r)
@@ -341,11 +369,12 @@ func (l *Line) setAtFilling(p int, r rune) {
 	l.setDirty()
 }
 
-// setStyledAtFilling sets given rune r at given position p of given
-// line l's content truncating all possibly following content and adds
-// a corresponding style range.
+// setStyledAtFilling sets given rune r at given cell at of given line
+// l's content truncating all possibly following content and adds a
+// corresponding style range.
 func (l *Line) setStyledAtFilling(at int, r rune, sty Style) {
-	l.setAtFilling(at, r)
+	at = l.atCell(at)
+	l.setRunesAtFilling(at, r)
 	if l.ss == nil {
 		l.ss = styleRanges{}
 	}
@@ -355,13 +384,15 @@ func (l *Line) setStyledAtFilling(at int, r rune, sty Style) {
 
 // AddStyleRange adds given style ranges sr and rr to given line l's
 // style ranges iff they don't overlap with already existing style ranges.
+// Note the ranges are screen cells of l's content, i.e. a wide rune is
+// covered by two cells.
 func (l *Line) AddStyleRange(sr SR, rr ...SR) {
 	if l.ss == nil {
 		l.ss = styleRanges{}
 	}
-	l.ss.add(sr.Range, sr.Style)
+	l.ss.add(l.runeRange(sr.Range), sr.Style)
 	for _, r := range rr {
-		l.ss.add(r.Range, r.Style)
+		l.ss.add(l.runeRange(r.Range), r.Style)
 	}
 	l.setDirty()
 }
@@ -375,41 +406,62 @@ type runeWriter interface {
 // width w it is truncated at w.
 func (l *Line) sync(x, y, width int, rw runeWriter, gg *Globals) {
 	l.setClean()
-	rr, ss := l.display(width, gg)
-	for i, r := range rr {
+	rr, ss, cc := l.displayCells(width, gg)
+	for i := range rr {
 		if i == width {
 			break
 		}
-		rw.Display(x+i, y, r, ss.of(i))
+		if r, ok := displayed(rr, i, width); ok {
+			displayCell(rw, x+i, y, r, cc, ss.of(i))
+		}
 	}
 }
 
 func (l *Line) vsync(x, y, height int, rw runeWriter, gg *Globals) {
 	l.setClean()
-	rr, ss := l.display(height, gg)
+	rr, ss, cc := l.displayCells(height, gg)
 	for i, r := range rr {
 		if i == height {
 			break
 		}
-		rw.Display(x, y+i, r, ss.of(i))
+		if r == wideCell {
+			r = ' '
+		}
+		displayCell(rw, x, y+i, r, cc, ss.of(i))
 	}
 }
 
 // display returns a line's calculated content depending on given width
 // and set filler as well as corresponding style ranges ready to print
-// to the screen.
+// to the screen, see displayCells.
 func (l *Line) display(width int, gg *Globals) ([]rune, styleRanges) {
+	rr, ss, _ := l.displayCells(width, gg)
+	return rr, ss
+}
+
+// displayCells returns a line's calculated content depending on given
+// width and set filler as well as corresponding style ranges ready to
+// print to the screen.  The returned runes are screen cells, i.e. a
+// wide cluster is followed by a wideCell placeholder and a cluster of
+// several runes is replaced by a placeholder which is mapped to its
+// runes by the returned clusters.
+func (l *Line) displayCells(width int, gg *Globals) (
+	[]rune, styleRanges, map[rune][]rune,
+) {
 	ss := l.ss.copyWithDefault(gg.Style(Default))
 	if len(l.rr) == 0 {
-		return l.displayEmpty(width, gg, ss)
+		rr, ss := l.displayEmpty(width, gg, ss)
+		return rr, ss, nil
 	}
 	rr := append([]rune{}, l.rr...)
 	rr, ss, tc := l.expandLeadingTabs(rr, ss, gg.tabWidth)
+	rr, ss, at, cc := expandClusters(rr, ss)
 	if len(rr) >= width {
-		return l.displayOverflowing(width, gg, rr, ss)
+		rr, ss = l.displayOverflowing(width, gg, rr, ss)
+		return rr, ss, cc
 	}
 	if len(l.fillAt) > 0 {
-		rr, ss = l.expandFillerAt(rr, width, ss, tc, gg)
+		rr, ss = l.expandFillerAt(rr, width, ss, tc, at, gg)
 	}
 	if len(rr) < width {
 		rr = l.pad(rr, width)
@@ -417,7 +469,7 @@ func (l *Line) display(width int, gg *Globals) ([]rune, styleRanges) {
 	if l.ff&(Highlighted|TrimmedHighlighted) != 0 {
 		ss = l.highlighted(rr, ss, gg)
 	}
-	return rr, ss
+	return rr, ss, cc
 }
 
 // displayEmpty returns width many space runes and adjust styles in case
@@ -454,9 +506,11 @@ func (l *Line) displayOverflowing(
 // given runes rr to fit given width exactly adjusting given style
 // ranges accordingly.  Note a previous tab-expansion shifting the
 // filler positions is taken into account by evaluating given tag count
-// tc and given globals providing the tab-width.
+// tc and given globals providing the tab-width as well as a previous
+// mapping of runes to cells by evaluating given mapping at, see
+// expandClusters.
 func (l *Line) expandFillerAt(
-	rr []rune, width int, ss styleRanges, tc int, gg *Globals,
+	rr []rune, width int, ss styleRanges, tc int, at []int, gg *Globals,
 ) ([]rune, styleRanges) {
 	fillAt := append([]int{}, l.fillAt...)
 	if tc > 0 { // adjust to tab expansion
@@ -465,6 +519,9 @@ func (l *Line) expandFillerAt(
 			fillAt[i] = f + tc
 		}
 	}
+	for i, f := range fillAt { // adjust to cell mapping
+		fillAt[i] = cellOf(at, f)
+	}
 	f := (width - (len(rr) - len(fillAt))) / len(fillAt)
 	mf := (width - (len(rr) - len(fillAt))) % len(fillAt)
 	ff := map[int][]rune{}
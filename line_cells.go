@@ -0,0 +1,270 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package lines
+
+import (
+	"unicode/utf8"
+
+	"github.com/mattn/go-runewidth"
+	"github.com/rivo/uniseg"
+)
+
+// A line's content is stored as runes while it is positioned, styled
+// and navigated in screen cells.  A screen cell displays a grapheme
+// cluster, i.e. a rune possibly followed by combining runes like an
+// accent or by runes joined to an emoji sequence.  A cluster whose first
+// rune is wide like an East Asian character or most emojis takes two
+// screen cells, i.e. a cluster takes as many cells as a backend draws
+// for it.  Hence the cell index and the rune index of a line's content
+// may differ.  Style ranges and fillers are stored with rune
+// indices and are mapped to cells as a line is displayed, see
+// expandClusters, whereas positions provided by the user are cell
+// positions which are mapped to rune indices as content is written,
+// see atCell.  Note a cluster is never split by these mappings.
+
+// wideCell is the placeholder of the second screen cell taken by a
+// wide cluster of a line's displayed content.
+const wideCell rune = 0
+
+// clusterRune is the first placeholder rune for a cluster of several
+// runes in a line's displayed content.  The placeholders are taken
+// from the supplementary private use area B.
+const clusterRune rune = 0x100000
+
+// cellWidth returns the number of screen cells a grapheme cluster of
+// given width w takes, i.e. 2 for a wide cluster and 1 otherwise.
+// Note a cluster of zero width like a lonely combining rune is given
+// its own cell.
+func cellWidth(w int) int {
+	if w >= 2 {
+		return 2
+	}
+	return 1
+}
+
+// cluster is a grapheme cluster of a line's content, i.e. the runes
+// from its start index to its end index which are displayed in one
+// screen cell respectively in two cells if the cluster is wide.
+type cluster struct{ start, end, width int }
+
+// clustersOf returns the grapheme clusters of given runes rr.  The
+// width of a cluster is the width of its first rune since this is the
+// width a terminal backend draws a cluster with.
+func clustersOf(rr []rune) []cluster {
+	cc, str, state, start := make([]cluster, 0, len(rr)), string(rr),
+		-1, 0
+	for len(str) > 0 {
+		var c string
+		c, str, _, state = uniseg.FirstGraphemeClusterInString(str, state)
+		end := start + utf8.RuneCountInString(c)
+		w := runewidth.RuneWidth(rr[start])
+		cc = append(cc, cluster{start, end, cellWidth(w)})
+		start = end
+	}
+	return cc
+}
+
+// cells returns the number of screen cells of given line l's content.
+func (l *Line) cells() int {
+	return l.cellAt(len(l.rr))
+}
+
+// cellAt returns the screen cell of the cluster having the rune with
+// given index idx of given line l's content.  An index after l's
+// content is assumed to be padded by spaces.
+func (l *Line) cellAt(idx int) int {
+	cell := 0
+	for _, c := range clustersOf(l.rr) {
+		if idx < c.end {
+			return cell
+		}
+		cell += c.width
+	}
+	return cell + idx - len(l.rr)
+}
+
+// clusterAt returns the start and end index of the cluster of given
+// line l's content which is displayed in given cell.  A cell after l's
+// content is assumed to be padded by spaces.
+func (l *Line) clusterAt(cell int) (start, end int) {
+	c := 0
+	for _, cl := range clustersOf(l.rr) {
+		c += cl.width
+		if c > cell {
+			return cl.start, cl.end
+		}
+	}
+	start = len(l.rr) + cell - c
+	return start, start + 1
+}
+
+// runeAt returns the index of the first rune of the cluster of given
+// line l's content which is displayed in given cell.
+func (l *Line) runeAt(cell int) int {
+	start, _ := l.clusterAt(cell)
+	return start
+}
+
+// lastCell returns the cell of given line l's last cluster or -1 if l
+// has no content.
+func (l *Line) lastCell() int {
+	if len(l.rr) == 0 {
+		return -1
+	}
+	return l.cellAt(len(l.rr) - 1)
+}
+
+// nextCell returns the cell of the cluster following the cluster at
+// given cell of given line l.
+func (l *Line) nextCell(cell int) int {
+	_, end := l.clusterAt(cell)
+	return l.cellAt(end)
+}
+
+// previousCell returns the cell of the cluster preceding the cluster at
+// given cell of given line l or 0 if there is no such cluster.
+func (l *Line) previousCell(cell int) int {
+	if cell <= 0 {
+		return 0
+	}
+	return l.cellAt(l.runeAt(cell - 1))
+}
+
+// runeStart returns given cell if it is the first cell of a cluster of
+// given line l; otherwise the first cell of the cluster which is
+// displayed in given cell.
+func (l *Line) runeStart(cell int) int {
+	return l.cellAt(l.runeAt(cell))
+}
+
+// atCell returns the index of the rune of given line l at which content
+// written at given cell starts.  A wide cluster which is only partially
+// overwritten is replaced by a space.
+func (l *Line) atCell(cell int) int {
+	idx := l.runeAt(cell)
+	if idx < len(l.rr) && l.cellAt(idx) < cell {
+		l.rr = append(l.rr[:idx], ' ')
+		idx++
+	}
+	return idx
+}
+
+// runeRange maps given cell range r of given line l to the
+// corresponding range of rune indices.
+func (l *Line) runeRange(r Range) Range {
+	if r[1] <= r[0] {
+		return Range{l.runeAt(r[0]), l.runeAt(r[0])}
+	}
+	_, end := l.clusterAt(r[1] - 1)
+	return Range{l.runeAt(r[0]), end}
+}
+
+// expandClusters maps given runes rr to screen cells, i.e. a cluster
+// of several runes is replaced by a placeholder and a wide cluster is
+// followed by a wideCell placeholder.  Given style ranges are mapped
+// to cells accordingly.  Returned are the cells, the mapped style
+// ranges, the cells of the runes of rr, see cellOf, and the clusters
+// of the placeholders.  Is no rune of rr mapped to a different cell
+// given runes and style ranges are returned.
+func expandClusters(rr []rune, ss styleRanges) (
+	[]rune, styleRanges, []int, map[rune][]rune,
+) {
+	cc, wide := clustersOf(rr), false
+	for _, c := range cc {
+		if c.width == 2 {
+			wide = true
+			break
+		}
+	}
+	if len(cc) == len(rr) && !wide {
+		return rr, ss, nil, nil
+	}
+	at, cells := make([]int, len(rr)+1), make([]rune, 0, len(rr))
+	var table map[rune][]rune
+	for _, c := range cc {
+		at[c.start] = len(cells)
+		for i := c.start + 1; i < c.end; i++ {
+			at[i] = len(cells) + c.width
+		}
+		if c.end-c.start == 1 {
+			cells = append(cells, rr[c.start])
+		} else {
+			if table == nil {
+				table = map[rune][]rune{}
+			}
+			p := clusterRune + rune(len(table))
+			table[p] = rr[c.start:c.end]
+			cells = append(cells, p)
+		}
+		if c.width == 2 {
+			cells = append(cells, wideCell)
+		}
+	}
+	at[len(rr)] = len(cells)
+	if ss == nil {
+		return cells, ss, at, table
+	}
+	mapped := styleRanges{}
+	for r, s := range ss {
+		if r == zeroRange {
+			mapped[r] = s
+			continue
+		}
+		if r := (Range{cellOf(at, r[0]), cellOf(at, r[1])}); r[0] < r[1] {
+			mapped[r] = s
+		}
+	}
+	return cells, mapped, at, table
+}
+
+// cellOf returns the cell of the rune with given index idx according
+// to given rune to cell mapping at, see expandClusters.  A rune which
+// isn't the first rune of its cluster is mapped to the cell after the
+// cluster.
+func cellOf(at []int, idx int) int {
+	switch {
+	case at == nil:
+		return idx
+	case idx >= len(at):
+		return at[len(at)-1] + idx - (len(at) - 1)
+	}
+	return at[idx]
+}
+
+// displayed returns the rune which is written to the screen for given
+// displayed runes rr at given index i within given width, i.e. false
+// is returned for the placeholder of a wide cluster's second cell which
+// is not written while a space is returned for a wide cluster which
+// doesn't fit completely into given width.
+func displayed(rr []rune, i, width int) (rune, bool) {
+	switch {
+	case rr[i] == wideCell && i == 0:
+		return ' ', true
+	case rr[i] == wideCell:
+		return 0, false
+	case i+1 == width && i+1 < len(rr) && rr[i+1] == wideCell:
+		return ' ', true
+	}
+	return rr[i], true
+}
+
+// displayCell writes given displayed rune r with given style s at given
+// coordinates using given rune writer rw whereas a cluster placeholder
+// is written as its cluster from given clusters cc.  Is rw not a
+// ClusterDisplayer only the cluster's first rune is written.
+func displayCell(
+	rw runeWriter, x, y int, r rune, cc map[rune][]rune, s Style,
+) {
+	c, ok := cc[r]
+	if !ok {
+		rw.Display(x, y, r, s)
+		return
+	}
+	if cd, ok := rw.(ClusterDisplayer); ok {
+		cd.DisplayCluster(x, y, c, s)
+		return
+	}
+	rw.Display(x, y, c[0], s)
+}
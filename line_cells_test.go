@@ -0,0 +1,254 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package lines
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	. "github.com/slukits/gounit"
+)
+
+// cellsFX returns a fixture of given width having one screen line whose
+// component displays given content and has given features.
+func cellsFX(
+	t *T, width int, content string, ff ...FeatureMask,
+) (*Fixture, *cmpFX) {
+	cmp := &cmpFX{onInit: func(c *cmpFX, e *Env) {
+		for _, f := range ff {
+			c.FF.Set(f)
+		}
+		fmt.Fprint(e, content)
+	}}
+	fx := fx(t, cmp)
+	fx.FireResize(width, 1)
+	return fx, cmp
+}
+
+type wideRunes struct{ Suite }
+
+func (s *wideRunes) SetUp(t *T) { t.Parallel() }
+
+func (s *wideRunes) Take_two_screen_cells(t *T) {
+	fx, _ := cellsFX(t, 8, "世界ab")
+	t.Eq("世界ab  ", fx.Screen())
+	cc := fx.Cells()[0]
+	t.Eq('界', cc[2].Rune)
+	t.Eq('a', cc[4].Rune)
+	t.Eq('b', cc[5].Rune)
+}
+
+func (s *wideRunes) Are_not_displayed_partially(t *T) {
+	fx, _ := cellsFX(t, 3, "世界")
+	t.Eq("世 ", fx.Screen())
+}
+
+func (s *wideRunes) Are_positioned_by_cells(t *T) {
+	fx, cmp := cellsFX(t, 6, "世界ab")
+	t.FatalOn(fx.Lines.Update(cmp, nil, func(e *Env) {
+		Print(e.LL(0).At(2), []rune("x"))
+	}))
+	t.Eq("世x   ", fx.Screen())
+	t.FatalOn(fx.Lines.Update(cmp, nil, func(e *Env) {
+		Print(e.LL(0).At(1), []rune("y"))
+	}))
+	t.Eq('y', fx.Cells()[0][1].Rune)
+}
+
+func (s *wideRunes) Are_styled_by_cells(t *T) {
+	fx, cmp := cellsFX(t, 6, "世界ab")
+	t.FatalOn(fx.Lines.Update(cmp, nil, func(e *Env) {
+		cmp.LL.By(0).AddStyleRange(SR{
+			Range: Range{2, 4}, Style: DefaultStyle.WithAA(Bold)})
+	}))
+	cc := fx.Cells()[0]
+	t.Not.True(cc.HasAA(0, Bold))
+	t.True(cc.HasAA(2, Bold))
+	t.Not.True(cc.HasAA(4, Bold))
+}
+
+func (s *wideRunes) Are_expanded_before_fillers(t *T) {
+	fx, _ := cellsFX(t, 6, "世"+Filler+"a")
+	t.Eq('a', fx.Cells()[0][5].Rune)
+}
+
+func (s *wideRunes) Are_skipped_by_the_cursor(t *T) {
+	fx, cmp := cellsFX(t, 8, "世a界", CellFocusable)
+	cursorAt := func(exp int) {
+		t.FatalOn(fx.Lines.Update(cmp, nil, func(e *Env) {
+			_, cl, _ := cmp.CursorPosition()
+			t.Eq(exp, cl)
+		}))
+	}
+	fx.FireKey(Down)
+	cursorAt(0)
+	fx.FireKey(Right)
+	cursorAt(2)
+	fx.FireKey(Right)
+	cursorAt(3)
+	fx.FireKey(Right)
+	cursorAt(3)
+	fx.FireKey(Left)
+	cursorAt(2)
+	fx.FireKey(Left)
+	cursorAt(0)
+	fx.FireKey(End)
+	cursorAt(3)
+}
+
+func (s *wideRunes) Overflow_by_their_cells(t *T) {
+	l := &Line{}
+	l.set("世界")
+	t.Eq(4, l.Len())
+	_, right, _ := l.isOverflowing(3)
+	t.True(right)
+	_, right, _ = l.isOverflowing(4)
+	t.Not.True(right)
+}
+
+func TestWideRunes(t *testing.T) {
+	t.Parallel()
+	Run(&wideRunes{}, t)
+}
+
+type graphemeClusters struct{ Suite }
+
+func (s *graphemeClusters) SetUp(t *T) { t.Parallel() }
+
+func (s *graphemeClusters) Take_one_cell_with_their_combining_runes(
+	t *T,
+) {
+	fx, _ := cellsFX(t, 4, "e\u0301ab")
+	t.Eq("e\u0301ab ", fx.Screen())
+	cc := fx.Cells()[0]
+	t.Eq('e', cc[0].Rune)
+	t.Eq([]rune{'\u0301'}, cc[0].Combining)
+	t.Eq('a', cc[1].Rune)
+	l := &Line{}
+	l.set("e\u0301ab")
+	t.Eq(3, l.Len())
+}
+
+func (s *graphemeClusters) Take_two_cells_if_wide(t *T) {
+	fx, _ := cellsFX(t, 4, "👩\u200d💻a")
+	t.Eq("👩\u200d💻a ", fx.Screen())
+	cc := fx.Cells()[0]
+	t.Eq([]rune{'\u200d', '💻'}, cc[0].Combining)
+	t.Eq('a', cc[2].Rune)
+}
+
+func (s *graphemeClusters) Take_one_cell_if_flags(t *T) {
+	fx, _ := cellsFX(t, 4, "🇩🇪a")
+	t.Eq("🇩🇪a  ", fx.Screen())
+	t.Eq('a', fx.Cells()[0][1].Rune)
+	l := &Line{}
+	l.set("🇩🇪a")
+	t.Eq(2, l.Len())
+}
+
+func (s *graphemeClusters) Take_one_cell_if_emoji_presentation_selected(
+	t *T,
+) {
+	fx, _ := cellsFX(t, 5, "❤\ufe0f☺\ufe0fa")
+	t.Eq("❤\ufe0f☺\ufe0fa  ", fx.Screen())
+	cc := fx.Cells()[0]
+	t.Eq('☺', cc[1].Rune)
+	t.Eq([]rune{'\ufe0f'}, cc[1].Combining)
+	t.Eq('a', cc[2].Rune)
+}
+
+// runesFX records the runes displayed in a screen line by their
+// x-coordinate.
+type runesFX map[int]rune
+
+func (rr runesFX) Display(x, _ int, r rune, _ Style) { rr[x] = r }
+
+func (s *graphemeClusters) Display_first_rune_without_cluster_display(
+	t *T,
+) {
+	l, rr := &Line{}, runesFX{}
+	l.set("e\u0301a")
+	l.sync(0, 0, 3, rr, newGlobals(nil))
+	t.Eq('e', rr[0])
+	t.Eq('a', rr[1])
+}
+
+func (s *graphemeClusters) Are_not_split_by_truncation(t *T) {
+	fx, _ := cellsFX(t, 3, "abe\u0301c")
+	t.Eq("abe\u0301", fx.Screen())
+	fx, _ = cellsFX(t, 3, "ab👩\u200d💻")
+	t.Eq("ab ", fx.Screen())
+}
+
+func (s *graphemeClusters) Are_styled_as_a_whole(t *T) {
+	fx, cmp := cellsFX(t, 4, "e\u0301ab")
+	t.FatalOn(fx.Lines.Update(cmp, nil, func(e *Env) {
+		cmp.LL.By(0).AddStyleRange(SR{
+			Range: Range{0, 1}, Style: DefaultStyle.WithAA(Bold)})
+	}))
+	cc := fx.Cells()[0]
+	t.True(cc.HasAA(0, Bold))
+	t.Not.True(cc.HasAA(1, Bold))
+}
+
+func (s *graphemeClusters) Are_overwritten_as_a_whole(t *T) {
+	fx, cmp := cellsFX(t, 4, "ae\u0301b")
+	t.FatalOn(fx.Lines.Update(cmp, nil, func(e *Env) {
+		Print(e.LL(0).At(1), []rune("x"))
+	}))
+	t.Eq("ax  ", fx.Screen())
+}
+
+func (s *graphemeClusters) Are_skipped_by_the_cursor(t *T) {
+	fx, cmp := cellsFX(t, 8, "e\u0301a👩\u200d💻b", CellFocusable)
+	cursorAt := func(exp int) {
+		t.FatalOn(fx.Lines.Update(cmp, nil, func(e *Env) {
+			_, cl, _ := cmp.CursorPosition()
+			t.Eq(exp, cl)
+		}))
+	}
+	fx.FireKey(Down)
+	cursorAt(0)
+	fx.FireKey(Right)
+	cursorAt(1)
+	fx.FireKey(Right)
+	cursorAt(2)
+	fx.FireKey(Right)
+	cursorAt(4)
+	fx.FireKey(Left)
+	cursorAt(2)
+	fx.FireKey(Left)
+	cursorAt(1)
+	fx.FireKey(Left)
+	cursorAt(0)
+}
+
+// keyFX is a key event reporting a given key.
+type keyFX Key
+
+func (k keyFX) When() time.Time     { return time.Time{} }
+func (k keyFX) Source() interface{} { return nil }
+func (k keyFX) Key() Key            { return Key(k) }
+func (k keyFX) Mod() ModifierMask   { return ZeroModifier }
+
+func (s *graphemeClusters) Are_backspaced_as_a_whole(t *T) {
+	fx, cmp := cellsFX(t, 8, "ae\u0301b", Editable)
+	fx.FireKey(Down)
+	fx.FireKey(Right)
+	fx.FireKey(Right)
+	t.FatalOn(fx.Lines.Update(cmp, nil, func(e *Env) {
+		_, cl, _ := cmp.CursorPosition()
+		t.Eq(2, cl)
+		edt := cmp.Edit.MapEvent(keyFX(Backspace))
+		t.FatalIfNot(t.True(edt != nil))
+		t.True(edt.Type == Del && edt.Cell == 1)
+	}))
+}
+
+func TestGraphemeClusters(t *testing.T) {
+	t.Parallel()
+	Run(&graphemeClusters{}, t)
+}
@@ -35,6 +35,16 @@ func (s *LineFocus) Current() int { return s.current }
 // Screen returns the screen line-index of the currently focused line or
 // -1 if no line is focused or it is not on the screen.
 func (s *LineFocus) Screen() int {
+	if s.c.isWrapping() {
+		if s.current < 0 {
+			return -1
+		}
+		r := s.c.rowOf(s.current) - s.c.row()
+		if r < 0 || r >= s.c.ContentScreenLines() {
+			return -1
+		}
+		return r
+	}
 	if s.current < 0 || s.current < s.c.First() ||
 		s.current-s.c.First() >= s.c.ContentScreenLines() {
 		return -1
@@ -67,6 +77,19 @@ func (s *LineFocus) AtCoordinate(y int) {
 	}
 	s.Reset()
 	ln := (*Line)(nil)
+	if s.c.isWrapping() {
+		idx, _ := s.c.lineAt(s.c.row() + lineIdx)
+		if idx >= s.c.Len() {
+			return
+		}
+		ln = (*s.c.ll)[idx]
+		if ln.ff&NotFocusable != 0 {
+			return
+		}
+		ln.Flag(Highlighted)
+		s.current = idx
+		return
+	}
 	if s.c.Src != nil {
 		ln = (*s.c.ll)[lineIdx]
 	} else {
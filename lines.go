@@ -24,6 +24,8 @@ following event interfaces with their reported event are defined:
   - [Focuser]: OnFocus(*Env): see [Lines.Focus], [Env.Focused]
   - [FocusLooser]: OnFocusLost(*Env) see [Lines.Focus], [Env.Focused]
   - [Updater]: OnUpdate(*Env, interface{}): see [Lines.Update]
+  - [Hider]: OnHide(*Env): see [Component.Hide]
+  - [Shower]: OnShow(*Env): see [Component.Show]
   - [Layouter]: OnLayout(*Env) bool: after layout change
   - [AfterLayouter]: OnAfterLayout(*Env, DD) bool: after OnLayout
   - [Keyer]: OnKey(*Env, Key, ModifierMask): special key like Esc
@@ -39,13 +41,13 @@ following event interfaces with their reported event are defined:
     mouse-move with pressed button
   - [Modaler]: OnOutOfBoundClick(*Env) bool: for modal layers
   - [OutOfBoundMover]: OnOutOfBoundMove(*Env) bool: for modal layers
+  - [Dismisser]: OnDismiss(*Env): modal layer removed by Esc
   - [LineSelecter]: OnLineSelection(*Env, int): [LineSelectable]
+  - [Paster]: OnPaste(*Env, string): bracketed paste
+  - [Resumer]: OnResume(*Env, error): see [Lines.Suspend]
 */
 type Eventer = api.Eventer
 
-// resizeEventer is reported when the Lines-display was resized.
-type resizeEventer = api.ResizeEventer
-
 // Dimer provides dimensions of a component in the layout.  Note each
 // type embedding [lines.Component] implements the Dimer interface.
 type Dimer = lyt.Dimer
@@ -137,7 +139,7 @@ func (q *quitting) Key(k Key) bool {
 // Lines listens to a backend implementation's reporting of events and
 // controls the event reporting to client components (see [Component])
 // and their layout accordingly.  Use one of the constructors [Term],
-// [TermKiosk] or [TermFixture] to obtain a Lines-instance.
+// [TermKiosk], [New] or [TermFixture] to obtain a Lines-instance.
 type Lines struct {
 
 	// scr to report resize events to screen components.
@@ -151,6 +153,25 @@ type Lines struct {
 	// backend is needed to post events.
 	backend api.EventProcessor
 
+	// timers keeps track of timers created by After and Tick.
+	timers *timers
+
+	// tasks keeps track of tasks started by Go.
+	tasks *tasks
+
+	// pasting buffers the content of a bracketed paste while it is
+	// reported; it is nil otherwise.
+	pasting *pasting
+
+	// panicking holds the handler registered by OnPanic.
+	panicking panicking
+
+	// recording holds the recorder set by Record.
+	recording recording
+
+	// quit is closed once the event-loop was quit.
+	quit chan struct{}
+
 	// Globals are properties whose changing is propagated to all its
 	// clones in components who update iff the updated property is still
 	// in sync with the origin.
@@ -159,11 +180,21 @@ type Lines struct {
 
 func newTerm(cmp Componenter) *Lines {
 	ll := Lines{}
-	ll.backend = term.New(ll.listen)
+	ll.init(term.New(ll.listen), cmp, false)
+	return &ll
+}
+
+// init sets up given Lines ll for given backend having given component
+// cmp as root.  Are timers manual they are driven by a [Fixture].
+func (ll *Lines) init(backend api.UIer, cmp Componenter, manual bool) {
+	ll.backend = backend
+	ll.timers = newTimers(backend, manual)
+	ll.tasks = newTasks(backend)
 	ll.Globals = newGlobals(nil)
-	ll.scr = newScreen(ll.backend.(api.UIer), cmp, ll.Globals)
+	ll.scr = newScreen(backend, cmp, ll.Globals)
 	ll.Globals.propagation = globalsPropagationClosure(ll.scr)
-	return &ll
+	ll.quit = make(chan struct{})
+	backend.OnQuit(func() { close(ll.quit) })
 }
 
 // Term returns a Lines l instance with a terminal backend displaying
@@ -257,6 +288,22 @@ func TermKiosk(cmp Componenter) *Lines {
 	return newTerm(cmp)
 }
 
+// TermInline returns a Lines instance like [Term] which doesn't take
+// over the whole terminal screen but displays given component cmp in
+// given number of lines starting at the line of the terminal's cursor,
+// i.e. typically below the shell prompt.  The layout manager treats
+// these lines as the screen.  The terminal is scrolled if there are not
+// enough lines below the cursor and the final frame is left in the
+// terminal's scrollback after quitting.  TermInline panics if the
+// terminal can't be obtained.
+func TermInline(cmp Componenter, height int) *Lines {
+	ll := &Lines{}
+	ll.init(term.NewInline(ll.listen, height), cmp, false)
+	ll.Quitting = &quitting{Mutex: &sync.Mutex{}}
+	ll.Quitting.AddRune('q')
+	return ll
+}
+
 // SetRoot replaces currently used root component by given component.
 func (ll *Lines) SetRoot(c Componenter) error {
 	if ll == nil || c == nil {
@@ -370,18 +417,9 @@ func (u *UpdateEvent) When() time.Time { return u.when }
 func (u *UpdateEvent) Source() interface{} { return u }
 
 func (ll *Lines) listen(evt api.Eventer) {
-	if evt, ok := evt.(RuneEventer); ok {
-		if ll.Quitting.Rune(evt.Rune()) {
-			ll.backend.Quit()
-			return
-		}
-	}
-	if evt, ok := evt.(KeyEventer); ok {
-		if ll.Quitting.Key(evt.Key()) {
-			ll.backend.Quit()
-			return
-		}
-	}
+	defer ll.recoverPanic()
+	ll.record(evt)
+	ll.expirePaste(evt)
 	switch evt := evt.(type) {
 	case *rootEvent:
 		ll.scr.setRoot(evt.newRoot, ll.Globals)
@@ -389,7 +427,9 @@ func (ll *Lines) listen(evt api.Eventer) {
 		ll.scr.hardSync(ll)
 	case *redrawEvent:
 		ll.scr.hardSync(ll)
-	case resizeEventer:
+	case *suspendEvent:
+		ll.suspend(evt)
+	case ResizeEventer:
 		width, height := evt.Size()
 		postSync := ll.scr.setSize(width, height, ll)
 		reportInit(ll, ll.scr)
@@ -397,11 +437,53 @@ func (ll *Lines) listen(evt api.Eventer) {
 		if postSync != nil {
 			postSync()
 		}
+	case *dumpEvent:
+		evt.err = ll.scr.lyt.Dump(evt.w, dumpLabel)
+		close(evt.done)
+	case *inspectEvent:
+		ll.scr.inspector = nil
+		if evt.on {
+			ll.scr.inspector = &inspector{x: -1, y: -1}
+		}
+		ll.scr.hardSync(ll)
+	case bracketPasteEventer:
+		ll.listenPaste(evt)
+		ll.sync(evt)
 	default:
+		if ll.pasting.buffer(evt) {
+			break
+		}
+		if ll.quits(evt) {
+			ll.backend.Quit()
+			return
+		}
 		report(evt, ll, ll.scr)
-		reportInit(ll, ll.scr)
-		ll.scr.softSync(ll)
+		ll.sync(evt)
+	}
+	ll.tasks.prune(ll.scr)
+}
+
+// quits returns true if given event evt is bound to quitting.
+func (ll *Lines) quits(evt api.Eventer) bool {
+	switch evt := evt.(type) {
+	case RuneEventer:
+		return ll.Quitting.Rune(evt.Rune())
+	case KeyEventer:
+		return ll.Quitting.Key(evt.Key())
+	}
+	return false
+}
+
+// sync reports not initialized components and syncs the screen after
+// given event evt was reported.
+func (ll *Lines) sync(evt api.Eventer) {
+	reportInit(ll, ll.scr)
+	if ll.scr.inspector != nil {
+		ll.scr.inspector.track(evt)
+		ll.scr.hardSync(ll)
+		return
 	}
+	ll.scr.softSync(ll)
 }
 
 // MoveFocus posts a new MoveFocus event into the event loop which once
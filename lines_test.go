@@ -5,10 +5,12 @@
 package lines
 
 import (
+	"errors"
 	"fmt"
 	"testing"
 
 	. "github.com/slukits/gounit"
+	"github.com/slukits/lines/internal/term"
 )
 
 type _lines struct{ Suite }
@@ -354,6 +356,94 @@ func (s *_lines) Reports_cursor_change_on_resize(t *T) {
 	t.FatalIfNot(t.Eq(2, cmp.N(onCursor)))
 }
 
+type resumerFX struct {
+	cmpFX
+	err     error
+	resumed int
+}
+
+func (c *resumerFX) OnResume(e *Env, err error) {
+	c.resumed++
+	c.err = err
+}
+
+func (s *_lines) Redraws_screen_after_suspension(t *T) {
+	fx := fx(t, &initFX{})
+	t.Eq(expInit, fx.Screen().Trimmed().String())
+	executed := false
+	fx.FireSuspend(func() error {
+		executed = true
+		t.Eq("", fx.Screen().Trimmed().String())
+		return nil
+	})
+	t.True(executed)
+	t.Eq(expInit, fx.Screen().Trimmed().String())
+}
+
+func (s *_lines) Preserves_focus_layers_and_cursor_on_suspension(t *T) {
+	stacking, layer := &stackingFX{}, &cmpFX{}
+	stacking.CC = append(stacking.CC, &cmpFX{}, &cmpFX{})
+	fx := fx(t, stacking)
+	cmp := stacking.CC[1].(*cmpFX)
+	fx.Lines.Update(cmp, nil, func(e *Env) {
+		cmp.Layered(e, layer, nil)
+		cmp.SetCursor(0, 0, BlockCursorBlinking)
+	})
+	t.FatalOn(fx.Lines.Focus(cmp))
+	x, y, _ := fx.Lines.CursorPosition()
+	fx.FireSuspend(nil)
+	fx.Lines.Update(cmp, nil, func(e *Env) {
+		t.Eq(cmp, e.Focused())
+		t.True(e.Lines.scr.lyt.Layers.Have(layer.layoutComponent()))
+	})
+	sx, sy, haveCursor := fx.Lines.CursorPosition()
+	t.True(haveCursor && sx == x && sy == y)
+	t.Eq(cmp, fx.Lines.CursorComponent())
+}
+
+func (s *_lines) Reports_resume_with_error_of_suspended_function(t *T) {
+	cmp, exp := &resumerFX{}, errors.New("suspended function failed")
+	fx := fx(t, cmp)
+	fx.FireSuspend(func() error { return exp })
+	t.Eq(1, cmp.resumed)
+	t.Eq(exp, cmp.err)
+	fx.FireSuspend(nil)
+	t.Eq(2, cmp.resumed)
+	t.True(cmp.err == nil)
+}
+
+func (s *_lines) Reports_to_component_of_given_backend(t *T) {
+	ui, tt := term.NewFixture(t.GoT(), 0)
+	cmp := &initFX{}
+	ll := New(ui, cmp)
+	t.Eq(expInit, tt.Screen().Trimmed().String())
+	reported := false
+	t.FatalOn(ll.Update(cmp, nil, func(e *Env) { reported = true }))
+	t.True(reported)
+}
+
+func (s *_lines) Runs_headless_with_screen_snapshots(t *T) {
+	cmp := &cmpFX{onInit: func(_ *cmpFX, e *Env) {
+		fmt.Fprint(e, expInit)
+	}}
+	hl := Headless(cmp, 40, 3)
+	defer hl.Quit()
+	hl.Sync()
+	t.Eq(expInit, hl.Screen().Trimmed().String())
+	t.FatalOn(hl.Update(cmp, nil, func(e *Env) {
+		fmt.Fprint(e.AA(Bold), "updated")
+	}))
+	hl.Sync()
+	t.Eq("updated", hl.Screen().Trimmed().String())
+	t.Eq(Bold, hl.Cells()[0][0].Style.AA())
+	t.FatalOn(hl.PostResize(20, 2))
+	hl.Sync()
+	t.Eq(2, len(hl.Screen()))
+	t.Eq(20, len(hl.Screen()[0]))
+	t.FatalOn(hl.PostRune('q', ZeroModifier))
+	hl.WaitForQuit()
+}
+
 func TestLines(t *testing.T) {
 	t.Parallel()
 	Run(&_lines{}, t)
@@ -0,0 +1,93 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package lines
+
+import "github.com/slukits/lines/internal/lyt"
+
+// modalFocus remembers for a modal layer which got the focus the
+// component which was focused before and the component which layered
+// the modal layer.
+type modalFocus struct {
+	modal        layoutComponenter
+	focus, owner Componenter
+}
+
+// pushModal pushes given modal layer l on the screen's stack of modal
+// layers which got the focus remembering the currently focused
+// component.
+func (s *screen) pushModal(l *lyt.Layer) {
+	modal := l.Root.(layoutComponenter)
+	for _, m := range s.modals {
+		if m.modal == modal {
+			return
+		}
+	}
+	mf := &modalFocus{modal: modal, focus: s.focus.userComponent()}
+	if lc, ok := s.lyt.Layers.Layered(l).(*layeredComponent); ok {
+		mf.owner = lc.userComponent()
+	}
+	s.modals = append(s.modals, mf)
+}
+
+// popRemovedModals removes all modal layers from the screen's stack of
+// modal layers which are no longer part of the layout and returns the
+// component the focus should be restored to or nil.  Which is the
+// component which was focused before the bottom-most removed modal
+// layer got the focus if it is still part of the layout; otherwise the
+// component which layered that modal layer.
+func (s *screen) popRemovedModals() (restore layoutComponenter) {
+	for i := len(s.modals) - 1; i >= 0; i-- {
+		m := s.modals[i]
+		if s.isLayerRoot(m.modal) {
+			continue
+		}
+		s.modals = append(s.modals[:i], s.modals[i+1:]...)
+		for _, c := range []Componenter{m.focus, m.owner} {
+			if c == nil || !c.hasLayoutWrapper() ||
+				!s.hasLayouted(c.layoutComponent()) {
+				continue
+			}
+			restore = c.layoutComponent()
+			break
+		}
+	}
+	return restore
+}
+
+// isLayerRoot returns true if given layout component lc is the root
+// of one of the screen's layers.
+func (s *screen) isLayerRoot(lc layoutComponenter) (is bool) {
+	s.lyt.Layers.For(func(l *lyt.Layer) (stop bool) {
+		is = l.Root == lc
+		return is
+	})
+	return is
+}
+
+// isInModal returns true if given layout component lc is part of the
+// top-most modal layer or if there is no modal layer.
+func (s *screen) isInModal(lc layoutComponenter) bool {
+	l := s.modalLayer()
+	return l == nil || l.Has(lc, nil)
+}
+
+// dismissModal removes the top-most modal layer after reporting
+// OnDismiss to it if it implements the Dismisser interface.
+func dismissModal(cntx *rprContext) {
+	l := cntx.scr.modalLayer()
+	if l == nil {
+		return
+	}
+	lc, ok := cntx.scr.lyt.Layers.Layered(l).(*layeredComponent)
+	if !ok {
+		return
+	}
+	mdl := l.Root.(layoutComponenter).userComponent()
+	if d, ok := mdl.(Dismisser); ok {
+		callback(mdl, cntx, d.OnDismiss)
+	}
+	owner := lc.userComponent()
+	callback(owner, cntx, owner.embedded().RemoveLayer)
+}
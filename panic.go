@@ -0,0 +1,77 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package lines
+
+import (
+	"runtime/debug"
+	"sync/atomic"
+
+	"github.com/slukits/lines/internal/api"
+)
+
+// PanicHandler is informed about a panic of a listener, see
+// [Lines.OnPanic].
+type PanicHandler func(recovered any, stack []byte) (resume bool)
+
+// panicking holds the panic handler of a Lines instance.
+type panicking struct {
+	handler atomic.Pointer[PanicHandler]
+}
+
+// OnPanic registers given handler h which is called back if a
+// listener panics while an event is reported.  Before h is called the
+// terminal is restored to the state it had before the Lines instance
+// was created, i.e. h may log or persist a crash report to stdout or
+// stderr.  h is provided with the recovered value and the stack trace
+// of the panicking listener.  Is true returned the event which caused
+// the panic is dropped, the screen is resumed and redrawn and the
+// event-loop keeps processing events.  Is false returned the Lines
+// instance is quit, i.e. [Lines.WaitForQuit] returns.  A previously
+// registered handler is replaced; a nil handler removes it in which
+// case a listener's panic isn't recovered:
+//
+//	ll := lines.Term(&App{}).OnPanic(
+//	    func(r any, stack []byte) bool {
+//	        fmt.Fprintf(os.Stderr, "app: panic: %v\n%s", r, stack)
+//	        return false
+//	    })
+//	ll.WaitForQuit()
+func (ll *Lines) OnPanic(h PanicHandler) *Lines {
+	if h == nil {
+		ll.panicking.handler.Store(nil)
+		return ll
+	}
+	ll.panicking.handler.Store(&h)
+	return ll
+}
+
+// recoverPanic is deferred by the listener of a Lines instance ll to
+// recover a panic of reported event if a panic handler is registered.
+// A recovered panic ends a bracketed paste or a split drag which was in
+// progress.
+func (ll *Lines) recoverPanic() {
+	h := ll.panicking.handler.Load()
+	if h == nil {
+		return
+	}
+	r := recover()
+	if r == nil {
+		return
+	}
+	stack := debug.Stack()
+	ll.pasting, ll.scr.splitDrag = nil, nil
+	s, suspendable := ll.backend.(api.Suspender)
+	if suspendable {
+		suspendable = s.Suspend() == nil
+	}
+	if !(*h)(r, stack) {
+		ll.backend.Quit()
+		return
+	}
+	if suspendable {
+		s.Resume()
+	}
+	ll.scr.hardSync(ll)
+}
@@ -0,0 +1,108 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package lines
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/slukits/gounit"
+	"github.com/slukits/lines/internal/api"
+)
+
+type _panic struct{ Suite }
+
+func (s *_panic) SetUp(t *T) { t.Parallel() }
+
+func (s *_panic) Provides_recovered_value_and_stack_to_handler(t *T) {
+	fx, cmp := fxCmp(t)
+	var recovered any
+	var stack []byte
+	fx.Lines.OnPanic(func(r any, s []byte) bool {
+		recovered, stack = r, s
+		return true
+	})
+	t.FatalOn(fx.Lines.Update(cmp, nil, func(e *Env) { panic("boom") }))
+	t.Eq("boom", recovered)
+	t.Contains(string(stack), "panic_test.go")
+}
+
+func (s *_panic) Keeps_processing_events_if_handler_resumes(t *T) {
+	fx, cmp := fxCmp(t)
+	fx.Lines.OnPanic(func(any, []byte) bool { return true })
+	t.FatalOn(fx.Lines.Update(cmp, nil, func(e *Env) {
+		fmt.Fprint(e, "before panic")
+	}))
+	t.FatalOn(fx.Lines.Update(cmp, nil, func(e *Env) { panic("boom") }))
+	t.Eq("before panic", fx.ScreenOf(cmp).Trimmed().String())
+	t.FatalOn(fx.Lines.Update(cmp, nil, func(e *Env) {
+		fmt.Fprint(e, "after panic")
+	}))
+	t.Eq("after panic", fx.ScreenOf(cmp).Trimmed().String())
+}
+
+// suspendSpy records if its backend is suspended.
+type suspendSpy struct {
+	api.EventProcessor
+	suspended bool
+}
+
+func (s *suspendSpy) Suspend() error {
+	s.suspended = true
+	return s.EventProcessor.(api.Suspender).Suspend()
+}
+
+func (s *suspendSpy) Resume() error {
+	s.suspended = false
+	return s.EventProcessor.(api.Suspender).Resume()
+}
+
+func (s *_panic) Disables_component_and_suspends_before_handler(t *T) {
+	fx, cmp := fxCmp(t)
+	spy, suspended, disabled := &suspendSpy{}, false, false
+	fx.Lines.OnPanic(func(any, []byte) bool {
+		suspended, disabled = spy.suspended, cmp.component == nil
+		return true
+	})
+	t.FatalOn(fx.Lines.Update(cmp, nil, func(e *Env) {
+		spy.EventProcessor = fx.Lines.backend
+		fx.Lines.backend = spy
+	}))
+	t.FatalOn(fx.Lines.Update(cmp, nil, func(e *Env) { panic("boom") }))
+	t.True(suspended)
+	t.True(disabled)
+	t.Not.True(spy.suspended)
+	t.FatalOn(fx.Lines.Update(cmp, nil, func(e *Env) {
+		fx.Lines.backend = spy.EventProcessor
+	}))
+}
+
+func (s *_panic) Quits_if_handler_does_not_resume(t *T) {
+	fx, cmp := fxCmp(t)
+	quitted := false
+	fx.Lines.OnQuit(func() { quitted = true })
+	fx.Lines.OnPanic(func(any, []byte) bool { return false })
+	t.FatalOn(fx.Lines.Update(cmp, nil, func(e *Env) { panic("boom") }))
+	t.True(quitted)
+}
+
+func (s *_panic) Is_not_recovered_after_handler_is_removed(t *T) {
+	fx, cmp := fxCmp(t)
+	handled := false
+	fx.Lines.OnPanic(func(any, []byte) bool { handled = true; return true })
+	fx.Lines.OnPanic(nil)
+	func() {
+		defer func() { recover() }()
+		fx.Lines.listen(&UpdateEvent{cmp: cmp, lst: func(e *Env) {
+			panic("boom")
+		}})
+	}()
+	t.Not.True(handled)
+}
+
+func TestPanic(t *testing.T) {
+	t.Parallel()
+	Run(&_panic{}, t)
+}
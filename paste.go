@@ -0,0 +1,173 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package lines
+
+import (
+	"strings"
+	"time"
+
+	"github.com/slukits/lines/internal/api"
+)
+
+// Paster is implemented by components which want to be informed about
+// bracketed pastes, i.e. text a user pasted into the terminal.  A paste
+// is reported as a whole instead of a sequence of rune events.  Hence
+// runes of a paste neither trigger rune listeners and features nor do
+// they quit the application.
+type Paster interface {
+
+	// OnPaste is called back with the pasted text if the implementing
+	// component is focused or an ancestor of the focused component.
+	// The paste bubbles up from the focused component through all its
+	// ancestors unless Env.StopBubbling is called.
+	OnPaste(e *Env, text string)
+}
+
+// bracketPasteEventer is reported by the backend at the start and the
+// end of a bracketed paste.
+type bracketPasteEventer = api.BracketPasteEventer
+
+// pasteTimeout is the maximal time span between an open bracketed
+// paste's last pasted content and the next event.  A later event ends
+// the paste as if its end was reported, i.e. a lost end of a paste
+// doesn't swallow all following user input.
+const pasteTimeout = time.Second
+
+// maxPaste is the maximal number of bytes which are buffered of a
+// bracketed paste; further pasted content is dropped.
+const maxPaste = 1 << 20
+
+// pasting buffers the rune and key events of a bracketed paste.
+type pasting struct {
+	strings.Builder
+
+	// last is the time of the latest event buffered by the paste.
+	last time.Time
+}
+
+// buffer adds given event evt to given bracketed paste p if it
+// represents pasted content and returns true; otherwise false is
+// returned, i.e. also if p is nil.
+func (p *pasting) buffer(evt api.Eventer) bool {
+	if p == nil {
+		return false
+	}
+	var r rune
+	switch evt := evt.(type) {
+	case RuneEventer:
+		r = evt.Rune()
+	case KeyEventer:
+		switch evt.Key() {
+		case Enter:
+			r = '\n'
+		case Tab:
+			r = '\t'
+		}
+	default:
+		return false
+	}
+	p.last = evt.When()
+	if r != 0 && p.Len() < maxPaste {
+		p.WriteRune(r)
+	}
+	return true
+}
+
+// listenPaste starts respectively ends buffering of pasted content as
+// reported by given bracketed paste event bp.
+func (ll *Lines) listenPaste(bp bracketPasteEventer) {
+	if bp.Start() {
+		ll.pasting = &pasting{last: bp.When()}
+		return
+	}
+	ll.endPaste(bp)
+}
+
+// expirePaste ends an open bracketed paste if given event evt happened
+// more than pasteTimeout after the paste's last content, see
+// pasteTimeout.
+func (ll *Lines) expirePaste(evt api.Eventer) {
+	if ll.pasting == nil {
+		return
+	}
+	if _, ok := evt.(bracketPasteEventer); ok {
+		return
+	}
+	if evt.When().Sub(ll.pasting.last) <= pasteTimeout {
+		return
+	}
+	ll.endPaste(evt)
+	reportInit(ll, ll.scr)
+	ll.scr.softSync(ll)
+}
+
+// endPaste reports the content of an open bracketed paste to the
+// focused component and its ancestors while given event evt is the
+// event which ended the paste.
+func (ll *Lines) endPaste(evt api.Eventer) {
+	if ll.pasting == nil {
+		return
+	}
+	text := ll.pasting.String()
+	ll.pasting = nil
+	if text == "" {
+		return
+	}
+	reportPaste(&rprContext{evt: evt, ll: ll, scr: ll.scr}, text)
+}
+
+// reportPaste reports given pasted text to the focused component and its
+// ancestors implementing the Paster interface.  Has the focused
+// component an active Editor the paste is only reported to the focused
+// component and if it doesn't stop bubbling the paste is reported as a
+// single insert Edit.
+func reportPaste(cntx *rprContext, text string) {
+	if cntx.scr.focus.userComponent().embedded().Edit.IsActive() {
+		if sb := reportOnPaste(cntx.scr.focus, text, cntx); sb {
+			return
+		}
+		reportPasteEdit(cntx.scr.focus, text, cntx)
+		return
+	}
+	cntx.scr.forFocused(func(c layoutComponenter) (stop bool) {
+		return reportOnPaste(c, text, cntx)
+	})
+}
+
+func reportOnPaste(
+	c layoutComponenter, text string, cntx *rprContext,
+) (stopBubbling bool) {
+	pst, ok := c.userComponent().(Paster)
+	if !ok {
+		return false
+	}
+	env := callback(c.userComponent(), cntx, func(e *Env) {
+		pst.OnPaste(e, text)
+	})
+	return env&envStopBubbling == envStopBubbling
+}
+
+func reportPasteEdit(
+	lc layoutComponenter, text string, cntx *rprContext,
+) {
+	ec, ok := lc.userComponent().(Editer)
+	if !ok {
+		return
+	}
+	editor := lc.userComponent().embedded().Edit
+	ln, cl, haveCursor := lc.wrapped().cursorPosition()
+	if !haveCursor {
+		panic("lines: report: on-paste: cursor position missing")
+	}
+	edt := &Edit{
+		Line: ln,
+		Cell: cl,
+		Type: editor.mode,
+		Text: text,
+	}
+	callback(lc.userComponent(), cntx, func(e *Env) {
+		ec.OnEdit(e, edt)
+	})
+}
@@ -0,0 +1,107 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package lines
+
+import (
+	"github.com/slukits/lines/internal/api"
+	"github.com/slukits/lines/internal/lyt"
+)
+
+// Place positions a component of a [Placer] at X and Y relative to the
+// placer's printable area having given Width and Height whereas a zero
+// size defaults to the component's fixed respectively minimal filling
+// size.  Of overlapping components the one with the higher z-level Z
+// is on top; of those with the same z-level the later provided one.  A
+// placed component is clipped at its placer's area, i.e. a negative X
+// or Y hides its left respectively top part.
+type Place = lyt.Place
+
+// Placer is implemented by components which want to provide nested
+// components which are placed at explicit positions inside their
+// screen area, e.g. the nodes of a graph.  Other than layers placed
+// components are positioned relative to their placer, are clipped at
+// its area and may only overlap each other.  Mouse events are reported
+// to the top-most placed component at the event's position.
+type Placer interface {
+
+	// ForPlaced calls back for each component of this Placer together
+	// with its place until the callback asks to stop.
+	ForPlaced(func(Componenter, Place) (stop bool))
+}
+
+// Placing embedded in a component makes the component implement the
+// Placer interface.  Typically the placed components are set in a
+// component's OnInit-listener:
+//
+//	type node struct{ lines.Component }
+//
+//	type graph struct{
+//		lines.Component
+//		lines.Placing
+//	}
+//
+//	func (c *graph) OnInit(_ *lines.Env) {
+//		c.CC = []lines.PlacedCmp{
+//			{Cmp: &node{}, Place: lines.Place{X: 2, Y: 1, Width: 12,
+//				Height: 3}},
+//			{Cmp: &node{}, Place: lines.Place{X: 30, Y: 4, Width: 12,
+//				Height: 3}},
+//		}
+//	}
+type Placing struct {
+
+	// CC holds the placed components.
+	CC []PlacedCmp
+}
+
+// PlacedCmp is a component placed at a [Place] of a [Placing]
+// component.
+type PlacedCmp struct {
+	Cmp Componenter
+	Place
+}
+
+// ForPlaced calls back for each component of this Placer together with
+// its place respectively until the callback asks to stop.
+func (p Placing) ForPlaced(cb func(Componenter, Place) (stop bool)) {
+	for _, c := range p.CC {
+		if cb(c.Cmp, c.Place) {
+			return
+		}
+	}
+}
+
+// placingWrapper wraps a placing user-component for the layout manager
+// which places its nested components at their places.
+type placingWrapper struct{ *component }
+
+func (pw *placingWrapper) Gaps() api.Gaps { return gapsOf(pw.component) }
+
+func (pw *placingWrapper) ForPlaced(cb func(lyt.Dimer, lyt.Place) bool) {
+	pw.userCmp.(Placer).ForPlaced(func(cmp Componenter, p Place) bool {
+		lc := pw.nested(cmp)
+		if lc == nil {
+			return false
+		}
+		return cb(lc, p)
+	})
+}
+
+// syncPlaced writes the placed components of given placing component c
+// to the screen in their z-order whereas the placed components on top
+// of a redrawn placed component are redrawn as well since they may
+// overlap it.
+func (c *component) syncPlaced(rw runeWriter) {
+	redrawn, rw := false, clipping(rw, c)
+	c.forNested(func(n *component) (stop bool) {
+		if redrawn {
+			n.hardSync(rw)
+			return false
+		}
+		redrawn = n.isDirtyButCursorMoved()
+		n.sync(rw)
+		return false
+	})
+}
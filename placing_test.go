@@ -0,0 +1,111 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package lines
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/slukits/gounit"
+)
+
+type _placing struct{ Suite }
+
+func (s *_placing) SetUp(t *T) { t.Parallel() }
+
+// placeFX returns a placing component with a gap at the top whose first
+// placed component overlaps its second one on a higher z-level.  The
+// placed components print their index.
+func placeFX() (*placingFX, []*cmpFX) {
+	cc := []*cmpFX{}
+	for i := 0; i < 2; i++ {
+		i := i
+		cc = append(cc, &cmpFX{onInit: func(c *cmpFX, e *Env) {
+			fmt.Fprintf(e, "%d%d%d%d", i, i, i, i)
+		}})
+	}
+	return &placingFX{
+		cmpFX: cmpFX{onInit: func(c *cmpFX, e *Env) {
+			fmt.Fprint(c.Gaps(0).Top, "")
+		}},
+		Placing: Placing{CC: []PlacedCmp{
+			{Cmp: cc[0], Place: Place{X: 2, Y: 1, Width: 4, Height: 2,
+				Z: 1}},
+			{Cmp: cc[1], Place: Place{X: 4, Y: 2, Width: 6, Height: 2}},
+		}},
+	}, cc
+}
+
+func (s *_placing) Places_relative_to_printable_area(t *T) {
+	plc, cc := placeFX()
+	fx := fx(t, plc)
+	fx.FireResize(12, 6)
+	x, y, w, h := fx.Dim(cc[0]).Printable()
+	t.Eq([4]int{2, 2, 4, 2}, [4]int{x, y, w, h})
+	x, y, w, h = fx.Dim(cc[1]).Printable()
+	t.Eq([4]int{4, 3, 6, 2}, [4]int{x, y, w, h})
+}
+
+func (s *_placing) Clips_placed_at_its_area(t *T) {
+	plc, cc := placeFX()
+	fx := fx(t, plc)
+	fx.FireResize(8, 4)
+	x, y, w, h := fx.Dim(cc[1]).Printable()
+	t.Eq([4]int{4, 3, 4, 1}, [4]int{x, y, w, h})
+	fx.FireResize(4, 4)
+	t.True(fx.Dim(cc[1]).IsOffScreen())
+}
+
+func (s *_placing) Clips_the_left_and_top_of_placed_at_its_area(t *T) {
+	c := &cmpFX{onInit: func(c *cmpFX, e *Env) {
+		fmt.Fprint(e, "abcd\nefgh")
+	}}
+	plc := &placingFX{
+		cmpFX: cmpFX{onInit: func(c *cmpFX, e *Env) {
+			fmt.Fprint(c.Gaps(0).Top, "••••••")
+		}},
+		Placing: Placing{CC: []PlacedCmp{{Cmp: c,
+			Place: Place{X: -2, Y: -1, Width: 4, Height: 2}}}},
+	}
+	fx := fx(t, plc)
+	fx.FireResize(6, 3)
+	t.Eq("••••••\ngh    \n      ", fx.Screen())
+	x, y, w, h := fx.Dim(c).Printable()
+	t.Eq([4]int{-2, 0, 4, 2}, [4]int{x, y, w, h})
+}
+
+func (s *_placing) Displays_placed_in_z_order(t *T) {
+	plc, cc := placeFX()
+	fx := fx(t, plc)
+	fx.FireResize(12, 5)
+	t.Eq("  0000      ", fx.Screen()[2])
+	t.Eq("      11    ", fx.Screen()[3])
+	t.FatalOn(fx.Lines.Update(cc[1], nil, func(e *Env) {
+		fmt.Fprint(e, "222222")
+	}))
+	t.Eq("      2222  ", fx.Screen()[3])
+}
+
+func (s *_placing) Reports_clicks_to_top_most_placed(t *T) {
+	plc, cc := placeFX()
+	clicked := []int{}
+	for i, c := range cc {
+		i := i
+		c.onMouse = func(_ *cmpFX, _ *Env, bm ButtonMask, _, _ int) {
+			if bm == Primary {
+				clicked = append(clicked, i)
+			}
+		}
+	}
+	fx := fx(t, plc)
+	fx.FireResize(12, 5)
+	fx.FireClick(5, 3).FireClick(7, 3).FireClick(4, 4)
+	t.Eq([]int{0, 1, 1}, clicked)
+}
+
+func TestPlacing(t *testing.T) {
+	t.Parallel()
+	Run(&_placing{}, t)
+}
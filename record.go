@@ -0,0 +1,124 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package lines
+
+import (
+	"encoding/json"
+	"io"
+	"sync/atomic"
+	"time"
+
+	"github.com/slukits/lines/internal/api"
+)
+
+// Types of recorded events, see [Lines.Record].
+const (
+	RecordedKey        = "key"
+	RecordedRune       = "rune"
+	RecordedMouse      = "mouse"
+	RecordedResize     = "resize"
+	RecordedPasteStart = "paste-start"
+	RecordedPasteEnd   = "paste-end"
+)
+
+// RecordedEvent is the JSON representation of a user input event
+// written by [Lines.Record] and read by [Fixture.Replay].  Type is one
+// of the Recorded* constants and determines which of the other fields
+// are set.  MS is the number of milliseconds passed since the
+// recording was started.
+type RecordedEvent struct {
+	MS     int64        `json:"ms"`
+	Type   string       `json:"type"`
+	Key    Key          `json:"key,omitempty"`
+	Rune   string       `json:"rune,omitempty"`
+	Mod    ModifierMask `json:"mod,omitempty"`
+	X      int          `json:"x,omitempty"`
+	Y      int          `json:"y,omitempty"`
+	Button ButtonMask   `json:"button,omitempty"`
+	Width  int          `json:"width,omitempty"`
+	Height int          `json:"height,omitempty"`
+}
+
+// recorder writes reported user input events to a writer.
+type recorder struct {
+	enc   *json.Encoder
+	start time.Time
+}
+
+// recording holds the recorder of a Lines instance if any.
+type recording struct {
+	recorder atomic.Pointer[recorder]
+}
+
+// Record writes each subsequently reported key, rune, mouse, resize
+// and bracketed paste event as a JSON line to given writer w, i.e. one
+// [RecordedEvent] per line, until Record is called again.  A nil
+// writer stops the recording which is also stopped on the first write
+// error.  A recorded session may be turned into a regression test with
+// [Fixture.Replay]:
+//
+//	f, _ := os.Create("session.jsonl")
+//	defer f.Close()
+//	lines.Term(&App{}).Record(f).WaitForQuit()
+//
+// Note aggregated mouse events like clicks or drags are not recorded
+// since they are derived from the recorded mouse events.
+func (ll *Lines) Record(w io.Writer) *Lines {
+	if w == nil {
+		ll.recording.recorder.Store(nil)
+		return ll
+	}
+	ll.recording.recorder.Store(&recorder{
+		enc: json.NewEncoder(w), start: time.Now()})
+	return ll
+}
+
+// record writes given event evt if it is a user input event and a
+// recording is active.
+func (ll *Lines) record(evt api.Eventer) {
+	r := ll.recording.recorder.Load()
+	if r == nil {
+		return
+	}
+	re := recorded(evt)
+	if re == nil {
+		return
+	}
+	if ms := evt.When().Sub(r.start).Milliseconds(); ms > 0 {
+		re.MS = ms
+	}
+	if err := r.enc.Encode(re); err != nil {
+		ll.recording.recorder.CompareAndSwap(r, nil)
+	}
+}
+
+// recorded returns the recorded representation of given event evt or
+// nil if evt is not a user input event.
+func recorded(evt api.Eventer) *RecordedEvent {
+	switch evt := evt.(type) {
+	case *MouseClick, *MouseMove, *MouseDrag, *MouseDrop:
+		return nil
+	case BracketPasteEventer:
+		if evt.Start() {
+			return &RecordedEvent{Type: RecordedPasteStart}
+		}
+		return &RecordedEvent{Type: RecordedPasteEnd}
+	case RuneEventer:
+		return &RecordedEvent{Type: RecordedRune,
+			Rune: string(evt.Rune()), Mod: evt.Mod()}
+	case KeyEventer:
+		return &RecordedEvent{Type: RecordedKey,
+			Key: evt.Key(), Mod: evt.Mod()}
+	case MouseEventer:
+		x, y := evt.Pos()
+		return &RecordedEvent{Type: RecordedMouse, X: x, Y: y,
+			Button: evt.Button(), Mod: evt.Mod()}
+	case ResizeEventer:
+		width, height := evt.Size()
+		return &RecordedEvent{Type: RecordedResize,
+			Width: width, Height: height}
+	}
+	return nil
+}
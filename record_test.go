@@ -0,0 +1,116 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package lines
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/slukits/gounit"
+)
+
+// sessionFX logs the user input it is informed about.
+type sessionFX struct {
+	pasterFX
+	log []string
+}
+
+func newSessionFX() *sessionFX {
+	c := &sessionFX{}
+	c.onRune = func(_ *cmpFX, _ *Env, r rune, _ ModifierMask) {
+		c.log = append(c.log, fmt.Sprintf("rune %c", r))
+	}
+	c.onKey = func(_ *cmpFX, _ *Env, k Key, m ModifierMask) {
+		c.log = append(c.log, fmt.Sprintf("key %d %d", k, m))
+	}
+	c.onPaste = func(_ *pasterFX, _ *Env, text string) {
+		c.log = append(c.log, "paste "+text)
+	}
+	return c
+}
+
+func (c *sessionFX) OnClick(_ *Env, x, y int) {
+	c.log = append(c.log, fmt.Sprintf("click %d %d", x, y))
+}
+
+type _record struct{ Suite }
+
+func (s *_record) SetUp(t *T) { t.Parallel() }
+
+func decodeRecorded(t *T, r *bytes.Buffer) (ee []RecordedEvent) {
+	dec := json.NewDecoder(r)
+	for dec.More() {
+		re := RecordedEvent{}
+		t.FatalOn(dec.Decode(&re))
+		ee = append(ee, re)
+	}
+	return ee
+}
+
+func (s *_record) Writes_user_input_events_as_json_lines(t *T) {
+	fx, recording := fx(t, newSessionFX()), &bytes.Buffer{}
+	fx.Lines.Record(recording)
+	fx.FireRune('x').FireKey(Enter, Alt).FireClick(1, 2)
+	fx.FireResize(30, 10).FirePaste("a\nb")
+	t.Eq(10, strings.Count(recording.String(), "\n"))
+	ee := decodeRecorded(t, recording)
+	t.FatalIfNot(t.Eq(10, len(ee)))
+	tt := []string{}
+	for _, e := range ee {
+		tt = append(tt, e.Type)
+	}
+	t.Eq(strings.Join([]string{RecordedRune, RecordedKey, RecordedMouse,
+		RecordedMouse, RecordedResize, RecordedPasteStart, RecordedRune,
+		RecordedRune, RecordedRune, RecordedPasteEnd}, ","),
+		strings.Join(tt, ","))
+	t.Eq("x", ee[0].Rune)
+	t.True(ee[1].Key == Enter && ee[1].Mod == Alt)
+	t.True(ee[2].X == 1 && ee[2].Y == 2 && ee[2].Button == Primary)
+	t.True(ee[4].Width == 30 && ee[4].Height == 10)
+}
+
+func (s *_record) Stops_recording_given_nil_writer(t *T) {
+	fx, recording := fx(t, newSessionFX()), &bytes.Buffer{}
+	fx.Lines.Record(recording)
+	fx.FireRune('x')
+	fx.Lines.Record(nil)
+	fx.FireRune('y')
+	ee := decodeRecorded(t, recording)
+	t.FatalIfNot(t.Eq(1, len(ee)))
+	t.Eq("x", ee[0].Rune)
+}
+
+func (s *_record) Session_replays_to_the_same_reports(t *T) {
+	recorded := newSessionFX()
+	fx1, recording := fx(t, recorded), &bytes.Buffer{}
+	fx1.Lines.Record(recording)
+	fx1.FireRune('x').FireKey(Enter, Alt).FireClick(1, 2)
+	fx1.FirePaste("a\nb")
+	replayed := newSessionFX()
+	fx(t, replayed).Replay(recording)
+	t.Eq(strings.Join(recorded.log, "|"), strings.Join(replayed.log, "|"))
+	t.Eq(4, len(replayed.log))
+}
+
+func (s *_record) Replay_advances_the_clock_between_events(t *T) {
+	cmp := newSessionFX()
+	fx := fx(t, cmp)
+	fx.Lines.After(cmp, time.Second, func(e *Env) {
+		cmp.log = append(cmp.log, "timer")
+	})
+	fx.Replay(strings.NewReader(
+		`{"ms":0,"type":"rune","rune":"a"}` + "\n" +
+			`{"ms":1500,"type":"rune","rune":"b"}` + "\n"))
+	t.Eq("rune a|timer|rune b", strings.Join(cmp.log, "|"))
+}
+
+func TestRecord(t *testing.T) {
+	t.Parallel()
+	Run(&_record{}, t)
+}
@@ -147,6 +147,10 @@ func report(
 		reportUpdate(cntx, evt)
 	case *moveFocusEvent:
 		reportMoveFocus(cntx, evt)
+	case *TimerEvent:
+		reportTimer(cntx, evt)
+	case *taskEvent:
+		reportTask(cntx, evt)
 	case RuneEventer:
 		return reportRune(cntx, evt)
 	case KeyEventer:
@@ -191,7 +195,7 @@ func reportMoveFocus(cntx *rprContext, evt *moveFocusEvent) {
 		evt.cmp.layoutComponent().wrapped().dim.IsOffScreen() {
 		return
 	}
-	if _, ok := cntx.scr.focus.userComponent().(Modaler); ok {
+	if !cntx.scr.isInModal(evt.cmp.layoutComponent()) {
 		return
 	}
 	moveFocus(evt.cmp, cntx)
@@ -225,7 +229,8 @@ func nestedInFocused(to Componenter, cntx *rprContext) bool {
 
 // setFocus reports OnFocus to all parents of the component to focus
 // which are not parents of the currently focused component and set the
-// screen focus.
+// screen focus.  Viewports containing the component to focus are
+// scrolled to have it displayed.
 func setFocus(to Componenter, cntx *rprContext) {
 	fPath, e1 := cntx.scr.lyt.Locate(cntx.scr.focus)
 	fPath = append(fPath, cntx.scr.focus)
@@ -252,6 +257,7 @@ func setFocus(to Componenter, cntx *rprContext) {
 		}
 	}
 	cntx.scr.focus = to.layoutComponent()
+	scrollIntoView(tPath)
 }
 
 // reportLostFocus reports focus lost to which are no parents of the
@@ -314,9 +320,11 @@ func callback(
 	env := cbEnv(cntx, cmp)
 
 	cmp.enable()
+	defer func() { // also if cb panics, see Lines.OnPanic
+		cmp.disable()
+		env.reset()
+	}()
 	cb(env)
-	cmp.disable()
-	env.reset()
 
 	return env.flags
 }
@@ -4,6 +4,13 @@
 
 package lines
 
+import (
+	"sort"
+
+	"github.com/slukits/lines/internal/api"
+	"github.com/slukits/lines/internal/lyt"
+)
+
 // LineSelecter is implemented by a component who wants to be informed
 // when its focused line was selected.
 type LineSelecter interface {
@@ -67,6 +74,8 @@ type Cursorer interface {
 // execute given feature f on given user-component usr.
 func execute(cntx *rprContext, usr Componenter, f FeatureMask) {
 	switch f {
+	case FocusMovable:
+		executeFocusMove(cntx)
 	case UpScrollable:
 		usr.embedded().Scroll.Up()
 	case DownScrollable:
@@ -89,6 +98,10 @@ func execute(cntx *rprContext, usr Componenter, f FeatureMask) {
 		reportSelectedLine(cntx, usr)
 	case editable:
 		editorInsert(cntx, usr)
+	case SplitBackwardMovable:
+		moveFocusedSplit(cntx, f, -1)
+	case SplitForwardMovable:
+		moveFocusedSplit(cntx, f, 1)
 	}
 }
 
@@ -112,6 +125,55 @@ func executeLineFocus(
 	reportCursorChange(cntx, usr)
 }
 
+// executeFocusMove moves the focus to the next FocusMovable component
+// in reading order after the focused component respectively to the
+// previous if the reported key is a back-tab.  Is there a modal layer
+// only its components are considered.  Components scrolled out of a
+// viewport are considered in the order of the viewport's canvas.
+func executeFocusMove(cntx *rprContext) {
+	m := cntx.scr.lyt
+	if l := cntx.scr.modalLayer(); l != nil {
+		m = l.Manager
+	}
+	cc, oo := []layoutComponenter{}, map[layoutComponenter]readingOrder{}
+	m.ForDimer(nil, func(d lyt.Dimer) (stop bool) {
+		lc := d.(layoutComponenter)
+		if !lc.wrapped().ff.has(FocusMovable) {
+			return false
+		}
+		if o, ok := readingOrderOf(m, lc); ok {
+			cc, oo[lc] = append(cc, lc), o
+		}
+		return false
+	})
+	if len(cc) == 0 {
+		return
+	}
+	sort.SliceStable(cc, func(i, j int) bool {
+		return oo[cc[i]].less(oo[cc[j]])
+	})
+	idx := -1
+	cntx.scr.forFocused(func(lc layoutComponenter) (stop bool) {
+		for i, c := range cc {
+			if c == lc {
+				idx = i
+				return true
+			}
+		}
+		return false
+	})
+	kevt, ok := cntx.evt.(api.KeyEventer)
+	switch {
+	case ok && kevt.Key() == Backtab && idx == -1:
+		idx = len(cc) - 1
+	case ok && kevt.Key() == Backtab:
+		idx = (idx - 1 + len(cc)) % len(cc)
+	default:
+		idx = (idx + 1) % len(cc)
+	}
+	moveFocus(cc[idx].userComponent(), cntx)
+}
+
 func executeResetLineFocus(cntx *rprContext, usr Componenter) {
 	cIdx, sIdx := usr.embedded().LL.Focus.Current(),
 		usr.embedded().LL.Focus.Screen()
@@ -184,6 +246,9 @@ func reportLineOverflow(cntx *rprContext, usr Componenter, sIdx int) {
 		return
 	}
 	cmp := usr.embedded()
+	if cmp.isWrapping() {
+		return
+	}
 	_, _, width, _ := cmp.ContentArea()
 	l, r, changed := cmp.LL.By(sIdx).isOverflowing(width)
 	if !l && !r || !changed {
@@ -36,7 +36,10 @@ func reportKey(cntx *rprContext, evt api.KeyEventer) (quit bool) {
 	if sb {
 		return false
 	}
-	execKeyFeature(cntx, evt)
+	if !execKeyFeature(cntx, evt) && evt.Key() == Esc &&
+		evt.Mod() == ZeroModifier {
+		dismissModal(cntx)
+	}
 	return false
 }
 
@@ -96,16 +99,54 @@ func keyCurry(
 	}
 }
 
-func execKeyFeature(cntx *rprContext, evt api.KeyEventer) {
+// execKeyFeature executes the feature bound to the key of given event
+// and returns true if there was such a feature.
+func execKeyFeature(cntx *rprContext, evt api.KeyEventer) bool {
 	usr := cntx.scr.focus.userComponent()
 	f := usr.layoutComponent().wrapped().ff.keyFeature(
 		evt.Key(), evt.Mod())
+	if f == NoFeature || f&Scrollable != NoFeature &&
+		!canScroll(usr.layoutComponent(), f) {
+		if _f, _usr := inheritedKeyFeature(cntx, evt); _f != NoFeature {
+			f, usr = _f, _usr
+		}
+	}
 	if f == NoFeature {
-		return
+		return false
 	}
 	usr.enable()
 	defer usr.disable()
 	execute(cntx, usr, f)
+	return true
+}
+
+// inheritedFeatures are features whose key bindings of a component
+// apply to all its nested components.
+const inheritedFeatures = FocusMovable | SplitBackwardMovable |
+	SplitForwardMovable | Scrollable
+
+// inheritedKeyFeature returns the inherited feature bound to the key of
+// given event by the innermost ancestor of the focused component having
+// it bound together with that ancestor.  It allows e.g. split features
+// to be executed or a viewport to be scrolled while the focus is in a
+// nested component which doesn't have them bound respectively can't
+// scroll any further.
+func inheritedKeyFeature(
+	cntx *rprContext, evt api.KeyEventer,
+) (f FeatureMask, usr Componenter) {
+	f = NoFeature
+	cntx.scr.forFocused(func(lc layoutComponenter) (stop bool) {
+		_f := lc.wrapped().ff.keyFeature(evt.Key(), evt.Mod())
+		if _f&inheritedFeatures == NoFeature {
+			return false
+		}
+		if _f&Scrollable != NoFeature && !canScroll(lc, _f) {
+			return false
+		}
+		f, usr = _f, lc.userComponent()
+		return true
+	})
+	return f, usr
 }
 
 func reportOnKey(
@@ -16,12 +16,13 @@ func mouseCurry(
 }
 
 func continueReportOnModal(
-	mc Modaler, cntx *rprContext, evt MouseEventer,
+	modal layoutComponenter, cntx *rprContext, evt MouseEventer,
 ) bool {
 	x, y := evt.Pos()
 	continueReport := true
-	if !cntx.scr.focus.Dim().Contains(x, y) {
-		callback(cntx.scr.focus.userComponent(), cntx, func(e *Env) {
+	if !modal.Dim().Contains(x, y) {
+		mc := modal.userComponent().(Modaler)
+		callback(modal.userComponent(), cntx, func(e *Env) {
 			continueReport = mc.OnOutOfBoundClick(e)
 		})
 	}
@@ -30,8 +31,8 @@ func continueReportOnModal(
 
 func cancelOnModal(cntx *rprContext, evt MouseEventer) bool {
 	x, y := evt.Pos()
-	if _, ok := cntx.scr.focus.userComponent().(Modaler); ok {
-		if !cntx.scr.focus.Dim().Contains(x, y) {
+	if modal := cntx.scr.haveModal(); modal != nil {
+		if !modal.Dim().Contains(x, y) {
 			return true
 		}
 	}
@@ -39,33 +40,35 @@ func cancelOnModal(cntx *rprContext, evt MouseEventer) bool {
 }
 
 func cancelOnModalDrag(cntx *rprContext, evt *MouseDrag) bool {
-	if _, ok := cntx.scr.focus.userComponent().(Modaler); !ok {
+	modal := cntx.scr.haveModal()
+	if modal == nil {
 		return false
 	}
-	drg, ok := cntx.scr.focus.userComponent().(Drager)
+	drg, ok := modal.userComponent().(Drager)
 	if !ok {
 		return true
 	}
 	x, y := evt.Pos()
-	callback(cntx.scr.focus.userComponent(), cntx,
+	callback(modal.userComponent(), cntx,
 		mouseCurry(drg.OnDrag, evt.Button(), x, y))
 	return true
 }
 
 func cancelOnModalMove(cntx *rprContext, evt *MouseMove) bool {
-	if _, ok := cntx.scr.focus.userComponent().(Modaler); !ok {
+	modal := cntx.scr.haveModal()
+	if modal == nil {
 		return false
 	}
 	x, y := evt.Pos()
-	if cntx.scr.focus.Dim().Contains(x, y) {
+	if modal.Dim().Contains(x, y) {
 		return false
 	}
-	oob, ok := cntx.scr.focus.userComponent().(OutOfBoundMover)
+	oob, ok := modal.userComponent().(OutOfBoundMover)
 	if !ok {
 		return true
 	}
 	continueReport := false
-	callback(cntx.scr.focus.userComponent(), cntx, func(e *Env) {
+	callback(modal.userComponent(), cntx, func(e *Env) {
 		continueReport = oob.OnOutOfBoundMove(e)
 	})
 	return !continueReport
@@ -167,10 +170,11 @@ func relative(ar func() (x, y, w, h int), x, y int) (rx, ry int) {
 
 func reportMouseClick(cntx *rprContext, evt *MouseClick) {
 	if evt.Button()&(api.Primary|api.Secondary) == 0 {
+		reportWheel(cntx, evt)
 		return
 	}
-	if mc, ok := cntx.scr.focus.userComponent().(Modaler); ok {
-		if !continueReportOnModal(mc, cntx, evt) {
+	if modal := cntx.scr.haveModal(); modal != nil {
+		if !continueReportOnModal(modal, cntx, evt) {
 			return
 		}
 	}
@@ -278,6 +282,9 @@ func reportMouseDrag(cntx *rprContext, evt *MouseDrag) {
 	if cancelOnModalDrag(cntx, evt) {
 		return
 	}
+	if dragSplit(cntx, evt) {
+		return
+	}
 
 	x, y := evt.Pos()
 	path, err := cntx.scr.lyt.LocateAt(x, y)
@@ -298,6 +305,9 @@ func reportMouseDrag(cntx *rprContext, evt *MouseDrag) {
 }
 
 func reportMouseDrop(cntx *rprContext, evt *MouseDrop) {
+	if dropSplit(cntx) {
+		return
+	}
 	if cancelOnModal(cntx, evt) {
 		return
 	}
@@ -350,6 +360,40 @@ func reportMouse(cntx *rprContext, evt MouseEventer) {
 	}
 }
 
+// reportWheel scrolls the innermost component at the position of given
+// mouse click which is up- respectively down-scrollable and can be
+// scrolled in that direction if the click's button is a wheel motion up
+// respectively down.
+func reportWheel(cntx *rprContext, evt *MouseClick) {
+	f := NoFeature
+	switch {
+	case evt.Button()&WheelUp != 0:
+		f = UpScrollable
+	case evt.Button()&WheelDown != 0:
+		f = DownScrollable
+	default:
+		return
+	}
+	if cancelOnModal(cntx, evt) {
+		return
+	}
+	path, err := cntx.scr.lyt.LocateAt(evt.Pos())
+	if err != nil {
+		return
+	}
+	for i := len(path) - 1; i >= 0; i-- {
+		lc := path[i].(layoutComponenter)
+		if !lc.wrapped().ff.has(f) || !canScroll(lc, f) {
+			continue
+		}
+		usr := lc.userComponent()
+		usr.enable()
+		defer usr.disable()
+		execute(cntx, usr, f)
+		return
+	}
+}
+
 func reportBubbling(
 	cntx *rprContext, path []lyt.Dimer, x, y int, relative bool,
 	implements func(Componenter) bool,
@@ -1,16 +1,122 @@
 package lines
 
 import (
+	"fmt"
 	"testing"
+	"time"
 
 	. "github.com/slukits/gounit"
 )
 
 type Report struct{ Suite }
 
-// func (s *Report) Bracket_paste_to_bracket_paster_implementation(t *T) {
-// 	t.TODO()
-// }
+func (s *Report) SetUp(t *T) { t.Parallel() }
+
+type pasterFX struct {
+	cmpFX
+	pasted  []string
+	onPaste func(*pasterFX, *Env, string)
+}
+
+func (c *pasterFX) OnPaste(e *Env, text string) {
+	c.pasted = append(c.pasted, text)
+	if c.onPaste == nil {
+		return
+	}
+	c.onPaste(c, e, text)
+}
+
+type stackingPasterFX struct {
+	pasterFX
+	Stacking
+}
+
+func (s *Report) Bracket_paste_to_bracket_paster_implementation(t *T) {
+	cmp := &pasterFX{}
+	fx := fx(t, cmp)
+	fx.FirePaste("pasted text")
+	t.Eq(1, len(cmp.pasted))
+	t.Eq("pasted text", cmp.pasted[0])
+	t.Eq(0, cmp.N(onRune))
+}
+
+func (s *Report) Bracket_paste_with_line_breaks_and_tabs(t *T) {
+	cmp := &pasterFX{}
+	fx := fx(t, cmp)
+	fx.PostBracketPaste("1st\n2nd\t2nd")
+	t.Eq("1st\n2nd\t2nd", cmp.pasted[0])
+}
+
+func (s *Report) Bracket_paste_without_quitting(t *T) {
+	cmp, quit := &pasterFX{}, false
+	fx := fx(t, cmp)
+	fx.Lines.OnQuit(func() { quit = true })
+	fx.FirePaste("quit")
+	t.Not.True(quit)
+	t.Eq("quit", cmp.pasted[0])
+	fx.FireRune('q')
+	t.True(quit)
+}
+
+// pasteStartFX is the start of a bracketed paste at a given time.
+type pasteStartFX struct{ when time.Time }
+
+func (e *pasteStartFX) When() time.Time     { return e.when }
+func (e *pasteStartFX) Source() interface{} { return e }
+func (e *pasteStartFX) Start() bool         { return true }
+func (e *pasteStartFX) End() bool           { return false }
+
+func (s *Report) Bracket_paste_end_after_timeout(t *T) {
+	cmp, quit := &pasterFX{}, false
+	fx := fx(t, cmp)
+	fx.Lines.OnQuit(func() { quit = true })
+	t.FatalOn(fx.Lines.backend.Post(&pasteStartFX{
+		when: time.Now().Add(-2 * pasteTimeout)}))
+	fx.FireRune('q')
+	t.True(quit)
+	t.Eq(0, len(cmp.pasted))
+}
+
+func (s *Report) Bracket_paste_bubbling_to_ancestors(t *T) {
+	inner, outer := &pasterFX{}, &stackingPasterFX{}
+	outer.CC = append(outer.CC, inner)
+	fx := fx(t, outer)
+	fx.Lines.Focus(inner)
+	fx.FirePaste("bubbled")
+	t.Eq("bubbled", inner.pasted[0])
+	t.Eq("bubbled", outer.pasted[0])
+
+	inner.onPaste = func(_ *pasterFX, e *Env, _ string) {
+		e.StopBubbling()
+	}
+	fx.FirePaste("stopped")
+	t.Eq(2, len(inner.pasted))
+	t.Eq(1, len(outer.pasted))
+}
+
+func (s *Report) Bracket_paste_as_single_edit_to_active_editor(t *T) {
+	var edits []*Edit
+	cmp := &pasterFX{cmpFX: cmpFX{
+		onInit: func(c *cmpFX, e *Env) {
+			fmt.Fprint(e, "1st\n2nd")
+		},
+		onEdit: func(c *cmpFX, e *Env, edt *Edit) bool {
+			edits = append(edits, edt)
+			return true
+		},
+	}}
+	fx := fx(t, cmp)
+	fx.Lines.Update(cmp, nil, func(e *Env) {
+		cmp.SetCursor(1, 1)
+		cmp.FF.Set(Editable)
+	})
+	fx.FirePaste("pasted")
+	t.FatalIfNot(t.Eq(1, len(edits)))
+	t.Eq("pasted", edits[0].Text)
+	t.Eq(Ins, edits[0].Type)
+	t.True(edits[0].Line == 1 && edits[0].Cell == 1)
+	t.Eq(0, cmp.N(onRune))
+}
 
 func TestReport(t *testing.T) {
 	t.Parallel()
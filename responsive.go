@@ -0,0 +1,186 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package lines
+
+import "github.com/slukits/lines/internal/lyt"
+
+// Breakpoint defines a layout variant of a [Responder] which is active
+// if the screen area of the responder has at least the breakpoint's
+// minimal width and height.
+type Breakpoint struct {
+
+	// MinWidth is the minimal width of a responder for which this
+	// breakpoint is active.
+	MinWidth int
+
+	// MinHeight is the minimal height of a responder for which this
+	// breakpoint is active.
+	MinHeight int
+
+	// Chaining chains the nested components of a responder if set;
+	// otherwise they are stacked.
+	Chaining bool
+
+	// Hidden are the indices of the nested components of a responder
+	// which are hidden while this breakpoint is active.
+	Hidden []int
+}
+
+// Responder is implemented by a component which stacks or chains its
+// nested components depending on the space it has on the screen.  Its
+// nested components are stacked unless a breakpoint is active, i.e.
+// the last breakpoint whose minimal width and height fit into its
+// screen area.  The active breakpoint is evaluated by each layout
+// change of a responder which is reported to its [Layouter]
+// implementation; use [Component.Breakpoint] to learn which breakpoint
+// is active.  Switching between breakpoints doesn't re-initialize the
+// nested components.
+type Responder interface {
+
+	// ForResponsive calls back for each nested component of a
+	// Responder until the callback asks to stop.
+	ForResponsive(func(Componenter) (stop bool))
+
+	// Breakpoints returns the breakpoints of a Responder ordered by
+	// increasing minimal width respectively height.
+	Breakpoints() []Breakpoint
+}
+
+// Responsive embedded in a component makes the component implement the
+// Responder interface.  Typically the Componenter slice and the
+// breakpoints are set in a component's [Initer]-listener:
+//
+//	type panes struct{
+//		lines.Component
+//		lines.Responsive
+//	}
+//
+//	func (c *panes) OnInit(_ *lines.Env) {
+//		c.CC = append(c.CC, &nav{}, &content{}, &details{})
+//		c.BB = []lines.Breakpoint{
+//			{MinWidth: 80, Chaining: true, Hidden: []int{2}},
+//			{MinWidth: 120, Chaining: true},
+//		}
+//	}
+type Responsive struct {
+	CC []Componenter
+	BB []Breakpoint
+}
+
+// ForResponsive calls back for each component of this Responder
+// respectively until the callback asks to stop.
+func (r Responsive) ForResponsive(cb func(Componenter) (stop bool)) {
+	for _, c := range r.CC {
+		if cb(c) {
+			return
+		}
+	}
+}
+
+// Breakpoints returns the breakpoints of this Responder.
+func (r Responsive) Breakpoints() []Breakpoint { return r.BB }
+
+// Breakpoint returns the index of the active breakpoint of a
+// [Responder] or -1 if none is active respectively the component is
+// no Responder.
+func (c *component) Breakpoint() int {
+	if _, ok := c.userCmp.(Responder); !ok {
+		return -1
+	}
+	return c.breakpoint
+}
+
+// responsiveHidden returns the indices of the nested components of
+// given responder r which are hidden by its breakpoint which is applied
+// to the layout.
+func (c *component) responsiveHidden(r Responder) map[int]bool {
+	bb := r.Breakpoints()
+	if c.layoutBreakpoint < 0 || c.layoutBreakpoint >= len(bb) {
+		return nil
+	}
+	hidden := map[int]bool{}
+	for _, idx := range bb[c.layoutBreakpoint].Hidden {
+		hidden[idx] = true
+	}
+	return hidden
+}
+
+// activeBreakpoint returns the index of the last of given breakpoints
+// whose minimal width and height fit into given width and height or -1
+// if there is none.
+func activeBreakpoint(bb []Breakpoint, width, height int) int {
+	active := -1
+	for i, b := range bb {
+		if b.MinWidth > width || b.MinHeight > height {
+			continue
+		}
+		active = i
+	}
+	return active
+}
+
+// evaluateBreakpoint activates the breakpoint of given layout component
+// lc which fits its screen area if it is a responder and returns true
+// if the active breakpoint has changed, i.e. needs to be applied to the
+// layout, see applyBreakpoint.
+func evaluateBreakpoint(lc layoutComponenter) bool {
+	c := lc.wrapped()
+	r, ok := c.userCmp.(Responder)
+	if !ok {
+		return false
+	}
+	_, _, width, height := c.dim.Screen()
+	active := activeBreakpoint(r.Breakpoints(), width, height)
+	if active == c.breakpoint {
+		return false
+	}
+	c.breakpoint = active
+	return true
+}
+
+// applyBreakpoint applies the active breakpoint of given responder's
+// layout component lc to the layout, i.e. lc stacks or chains its
+// nested components according to the active breakpoint while the focus
+// is moved to lc if it was in a nested component which is hidden by
+// the active breakpoint.
+func (s *screen) applyBreakpoint(cntx *rprContext, lc layoutComponenter) {
+	c := lc.wrapped()
+	r := c.userCmp.(Responder)
+	c.layoutBreakpoint = c.breakpoint
+	hidden, i := c.responsiveHidden(r), -1
+	r.ForResponsive(func(cmp Componenter) (stop bool) {
+		i++
+		if !hidden[i] || !cmp.hasLayoutWrapper() ||
+			!s.lyt.Has(s.focus, cmp.layoutComponent()) {
+			return false
+		}
+		moveFocus(c.userCmp, cntx)
+		return true
+	})
+}
+
+// isResponsiveHidden returns true iff given layout component lc is
+// nested in a component which is hidden by the active breakpoint of a
+// responder of the layout.
+func (s *screen) isResponsiveHidden(lc layoutComponenter) (hidden bool) {
+	s.lyt.ForDimer(nil, func(d lyt.Dimer) (stop bool) {
+		c := d.(layoutComponenter).wrapped()
+		r, ok := c.userCmp.(Responder)
+		if !ok {
+			return false
+		}
+		hh, i := c.responsiveHidden(r), -1
+		r.ForResponsive(func(cmp Componenter) (stop bool) {
+			i++
+			if !hh[i] || !cmp.hasLayoutWrapper() {
+				return false
+			}
+			hidden = s.lyt.Has(lc, cmp.layoutComponent())
+			return hidden
+		})
+		return hidden
+	})
+	return hidden
+}
@@ -0,0 +1,123 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package lines
+
+import (
+	"testing"
+
+	. "github.com/slukits/gounit"
+)
+
+type _responsive struct{ Suite }
+
+func (s *_responsive) SetUp(t *T) { t.Parallel() }
+
+// responder returns a responder with three nested components which
+// hides its last component if it is narrower than 60 cells and chains
+// its components otherwise.
+func responder() (*responsiveFX, []*cmpFX) {
+	cc, rsp := []*cmpFX{{}, {}, {}}, &responsiveFX{}
+	for _, c := range cc {
+		rsp.CC = append(rsp.CC, c)
+	}
+	rsp.BB = []Breakpoint{
+		{Hidden: []int{2}},
+		{MinWidth: 60, Chaining: true},
+	}
+	return rsp, cc
+}
+
+func (s *_responsive) Chains_nested_at_wide_breakpoint(t *T) {
+	rsp, cc := responder()
+	fx := fx(t, rsp)
+	x, y, w, h := fx.Dim(cc[1]).Printable()
+	t.True(x == 27 && y == 0 && w == 27 && h == 25)
+}
+
+func (s *_responsive) Stacks_nested_at_narrow_breakpoint(t *T) {
+	rsp, cc := responder()
+	fx := fx(t, rsp)
+	fx.FireResize(40, 20)
+	x, y, w, h := fx.Dim(cc[1]).Printable()
+	t.True(x == 0 && y == 10 && w == 40 && h == 10)
+}
+
+func (s *_responsive) Hides_optional_nested_at_breakpoint(t *T) {
+	rsp, cc := responder()
+	fx := fx(t, rsp)
+	fx.FireResize(40, 20)
+	fx.FireClick(5, 15)
+	t.True(cc[1].N(onMouseN) > 0)
+	t.Eq(0, cc[2].N(onMouseN))
+	t.Not.True(fx.Lines.scr.lyt.Has(cc[2].layoutComponent(), nil))
+}
+
+func (s *_responsive) Keeps_nested_initialized_when_switching(t *T) {
+	rsp, cc := responder()
+	fx := fx(t, rsp)
+	fx.FireResize(40, 20)
+	fx.FireResize(80, 25)
+	for _, c := range cc {
+		t.Eq(1, c.N(onInit))
+	}
+	_, _, w, _ := fx.Dim(cc[2]).Printable()
+	t.Eq(26, w)
+}
+
+func (s *_responsive) Reports_active_breakpoint_on_layout(t *T) {
+	rsp, _ := responder()
+	bb := []int{}
+	rsp.onLayout = func(c *cmpFX, e *Env) {
+		bb = append(bb, rsp.Breakpoint())
+	}
+	fx := fx(t, rsp)
+	fx.FireResize(40, 20)
+	t.Eq([]int{1, 0}, bb)
+}
+
+func (s *_responsive) Reports_layout_of_active_breakpoint_on_layout(
+	t *T,
+) {
+	rsp, cc := responder()
+	yy := []int{}
+	rsp.onLayout = func(c *cmpFX, e *Env) {
+		_, y, _, _ := cc[1].layoutComponent().Dim().Printable()
+		yy = append(yy, y)
+	}
+	fx := fx(t, rsp)
+	fx.FireResize(40, 20)
+	t.Eq([]int{0, 10}, yy)
+}
+
+func (s *_responsive) Moves_focus_out_of_hidden_nested(t *T) {
+	rsp, cc := responder()
+	fx := fx(t, rsp)
+	t.FatalOn(fx.Lines.Focus(cc[2]))
+	fx.FireResize(40, 20)
+	t.Eq(rsp, fx.Lines.scr.focus.userComponent())
+}
+
+func (s *_responsive) Switches_if_layered(t *T) {
+	rsp, cc := responder()
+	base := &cmpFX{}
+	fx := fx(t, base)
+	t.FatalOn(fx.Lines.Update(base, nil, func(e *Env) {
+		base.Layered(e, rsp, NewLayerPos(0, 0, 80, 20))
+	}))
+	x, y, w, _ := fx.Dim(cc[1]).Printable()
+	t.True(x == 27 && y == 0 && w == 27)
+	fx.FireResize(40, 20)
+	t.FatalOn(fx.Lines.Update(base, nil, func(e *Env) {
+		base.RemoveLayer(e)
+		base.Layered(e, rsp, NewLayerPos(0, 0, 40, 20))
+	}))
+	x, y, w, _ = fx.Dim(cc[1]).Printable()
+	t.True(x == 0 && y == 10 && w == 40)
+}
+
+func TestResponsive(t *testing.T) {
+	t.Parallel()
+	Run(&_responsive{}, t)
+}
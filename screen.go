@@ -79,6 +79,33 @@ type Chainer interface {
 	ForChained(func(Componenter) (stop bool))
 }
 
+// Grider is implemented by components which want to provide nested
+// components which are arranged in the cells of a grid whose columns
+// and rows are aligned across all nested components.
+type Grider interface {
+
+	// Tracks returns the column and row tracks of this Grider's grid.
+	Tracks() (columns, rows []Track)
+
+	// ForGridded calls back for each component of this Grider together
+	// with the cell it is placed in until the callback asks to stop.
+	ForGridded(func(Componenter, Cell) (stop bool))
+}
+
+// Decker is implemented by components which want to provide nested
+// components of which exactly one is shown, i.e. layed out, at a time.
+// Decked components which are not shown keep their state but are not
+// part of the layout.
+type Decker interface {
+
+	// ForDecked calls back for each component of this Decker until the
+	// callback asks to stop.
+	ForDecked(func(Componenter) (stop bool))
+
+	// Current returns the index of the decked component which is shown.
+	Current() int
+}
+
 type cursor [3]int
 
 // X returns set x coordinate of given cursor c's position which is -1
@@ -132,6 +159,21 @@ type screen struct {
 	focus   layoutComponenter
 	mouseIn layoutComponenter
 	cursor  *cursor
+
+	// splitDrag is the split which is currently dragged if any.
+	splitDrag *splitDrag
+
+	// modals is the stack of modal layers which got the focus.
+	modals []*modalFocus
+
+	// toggled are the components which were hidden or shown since the
+	// last sync while hidden are the components which are hidden.
+	toggled []*component
+	hidden  map[*component]bool
+
+	// inspector is set while the layout inspector is turned on, see
+	// [Lines.Inspect].
+	inspector *inspector
 }
 
 func newScreen(backend api.UIer, cmp Componenter, gg *Globals) *screen {
@@ -311,6 +353,7 @@ func (s *screen) hardSync(ll *Lines) {
 		l.Root.(layoutComponenter).wrapped().hardSync(s.backend)
 		return false
 	})
+	s.inspector.draw(s)
 	s.backend.Redraw()
 	s.ensureFocus(ll)
 }
@@ -383,70 +426,149 @@ type layereder interface {
 }
 
 func (s *screen) ensureFocus(ll *Lines) {
-	if modal := s.haveModal(); modal != nil {
-		if modal == s.focus {
+	cntx := &rprContext{ll: ll, scr: s}
+	restore := s.popRemovedModals()
+	if l := s.modalLayer(); l != nil {
+		if l.Has(s.focus, nil) {
 			return
 		}
-		moveFocus(modal.userComponent(), &rprContext{ll: ll, scr: s})
+		if restore != nil && l.Has(restore, nil) {
+			moveFocus(restore.userComponent(), cntx)
+			return
+		}
+		s.pushModal(l)
+		moveFocus(l.Root.(layoutComponenter).userComponent(), cntx)
+		return
+	}
+	if restore != nil {
+		moveFocus(restore.userComponent(), cntx)
 		return
 	}
-	if s.lyt.Has(s.focus, nil) || s.lyt.Layers.Have(s.focus) {
+	if s.hasLayouted(s.focus) {
 		return
 	}
-	moveFocus(
-		s.lyt.Root.(layoutComponenter).userComponent(),
-		&rprContext{ll: ll, scr: s},
-	)
+	moveFocus(s.lyt.Root.(layoutComponenter).userComponent(), cntx)
+}
+
+// hasLayouted returns true if given layout component lc is part of the
+// screen's base layout or of one of its layers.
+func (s *screen) hasLayouted(lc layoutComponenter) bool {
+	return s.lyt.Has(lc, nil) || s.lyt.Layers.Have(lc)
+}
+
+// isInLayout returns true iff given componenter cmp is part of the
+// screen's layout.
+func (s *screen) isInLayout(cmp Componenter) bool {
+	if !cmp.hasLayoutWrapper() {
+		return false
+	}
+	path, err := s.lyt.Locate(cmp.layoutComponent())
+	if err == nil && path != nil {
+		return true
+	}
+	return s.isDecked(cmp.layoutComponent()) ||
+		s.isHidden(cmp.layoutComponent())
 }
 
-func (s *screen) haveModal() (lc layoutComponenter) {
+// haveModal returns the root of the top-most modal layer or nil.
+func (s *screen) haveModal() layoutComponenter {
+	if l := s.modalLayer(); l != nil {
+		return l.Root.(layoutComponenter)
+	}
+	return nil
+}
+
+// modalLayer returns the top-most layer whose root component
+// implements the Modaler interface or nil.
+func (s *screen) modalLayer() (lyr *lyt.Layer) {
 	s.lyt.Layers.ForReversed(func(l *lyt.Layer) (stop bool) {
 		_, ok := l.Root.(layoutComponenter).userComponent().(Modaler)
 		if !ok {
 			return false
 		}
-		lc = l.Root.(layoutComponenter)
+		lyr = l
 		return true
 	})
-	return lc
+	return lyr
 }
 
 // syncReflowLayout reflows the layout and reports to every component
-// with changed layout implementing Layouter.  It also calls back for
-// every component with changed layout if callback not nil.
+// with changed layout implementing Layouter.  A responder whose active
+// breakpoint changed is reported after the layout of its breakpoint was
+// applied.  It also calls back for every component with changed layout
+// if callback not nil.
 func (s *screen) syncReflowLayout(
 	lines *Lines, hard bool, cb func(Componenter),
 ) {
-	cntx, count := &rprContext{ll: lines, scr: s}, 0
+	cntx, count, relayout := &rprContext{ll: lines, scr: s}, 0, false
 	reflow := s.lyt.IsDirty()
+	switched, toggled := s.syncDecks(lines), s.syncHidden(lines)
+	if switched || toggled {
+		reportInit(lines, s)
+		reflow = true
+	}
 	if hard {
 		reflow = true
 	}
+	reportLayout := func(lc layoutComponenter) {
+		cmp := lc.userComponent()
+		if lyt, ok := cmp.(Layouter); ok {
+			callback(cmp, cntx, func(e *Env) {
+				if lyt.OnLayout(e) {
+					reflow = true
+				}
+			})
+		}
+	}
+	// pending holds the responders whose layout is reported after the
+	// layout of their changed breakpoint was applied.
+	pending := []layoutComponenter{}
 	for reflow && count < 10 {
-		reflow = false
-		ll := s.lyt.Layers
+		reflow, relayout = false, false
+		ll, responders := s.lyt.Layers, []layoutComponenter{}
+		reported := map[layoutComponenter]bool{}
 		s.lyt.Reflow(func(d lyt.Dimer) {
-			cmp := d.(layoutComponenter).userComponent()
-			if lyt, ok := cmp.(Layouter); ok {
-				callback(cmp, cntx, func(e *Env) {
-					reflow = lyt.OnLayout(e)
-				})
+			lc := d.(layoutComponenter)
+			if setPendingSplits(lc) {
+				relayout = true
+			}
+			if evaluateBreakpoint(lc) {
+				responders = append(responders, lc)
+			} else {
+				reportLayout(lc)
 			}
+			reported[lc] = true
 			if cb != nil {
-				cb(cmp)
+				cb(lc.userComponent())
 			}
 		})
+		for _, lc := range pending {
+			if !reported[lc] {
+				reportLayout(lc)
+			}
+		}
+		pending = responders
 		if cb != nil {
 			s.lyt.Layers.BaseDimerLayeredByReMovedLayers(
 				s.lyt, ll, func(d lyt.Dimer) {
 					cb(d.(layoutComponenter).userComponent())
 				})
 		}
+		for _, lc := range responders {
+			s.applyBreakpoint(cntx, lc)
+			relayout = true
+		}
+		if relayout {
+			reflow = true
+		}
 		if reflow {
 			count++
 			reportInit(lines, s)
 		}
 	}
+	for _, lc := range pending {
+		reportLayout(lc)
+	}
 }
 
 // Stacking embedded in a component makes the component implement the
@@ -508,3 +630,131 @@ func (cg Chaining) ForChained(cb func(Componenter) (stop bool)) {
 		}
 	}
 }
+
+// Track describes the size of a column or row of a [Grider]'s grid
+// which is either fixed or filling, see [TrackFixed], [TrackFilling]
+// and [TrackFraction].
+type Track = lyt.Track
+
+// TrackFixed returns a grid track with given fixed size.
+var TrackFixed = lyt.TrackFixed
+
+// TrackFilling returns a grid track which fills its grid but doesn't
+// shrink below given minimal size.
+var TrackFilling = lyt.TrackFilling
+
+// TrackFraction returns a filling grid track with given minimal size
+// which receives a share of the left over space of its grid
+// proportional to given weight.
+var TrackFraction = lyt.TrackFraction
+
+// Cell places a component in a grid at given zero based column and row
+// index spanning ColumnSpan columns and RowSpan rows whereas a zero
+// span is interpreted as one.  A component whose cell is outside the
+// grid's tracks is off-screen.
+type Cell = lyt.Cell
+
+// Griding embedded in a component makes the component implement the
+// Grider interface.  Typically the tracks and the gridded components
+// are set in a component's OnInit-listener:
+//
+//	type griddedCmp struct { lines.Component }
+//
+//	type myCmp struct{
+//		lines.Component
+//		lines.Griding
+//	}
+//
+//	func (c *myCmp) OnInit(_ *lines.Env) {
+//		c.Columns = []lines.Track{
+//			lines.TrackFixed(20), lines.TrackFraction(1, 2)}
+//		c.Rows = []lines.Track{lines.TrackFilling(1)}
+//		c.CC = []lines.GridCell{
+//			{Cmp: &griddedCmp{}},
+//			{Cmp: &griddedCmp{}, Cell: lines.Cell{Column: 1}},
+//		}
+//	}
+type Griding struct {
+
+	// Columns and Rows are the tracks of the grid.
+	Columns, Rows []Track
+
+	// CC holds the gridded components.
+	CC []GridCell
+}
+
+// GridCell is a component placed in a cell of a [Griding] grid.
+type GridCell struct {
+	Cmp Componenter
+	Cell
+}
+
+// Tracks returns the column and row tracks of this Grider.
+func (g Griding) Tracks() (columns, rows []Track) {
+	return g.Columns, g.Rows
+}
+
+// ForGridded calls back for each component of this Grider together
+// with its cell respectively until the callback asks to stop.
+func (g Griding) ForGridded(cb func(Componenter, Cell) (stop bool)) {
+	for _, c := range g.CC {
+		if cb(c.Cmp, c.Cell) {
+			return
+		}
+	}
+}
+
+// Decking embedded in a component makes the component implement the
+// Decker interface, i.e. of its decked components CC only the current
+// one is shown which fills the whole decking component.  Typically the
+// decked components are set in a component's OnInit-listener and later
+// switched by [Decking.Show]:
+//
+//	type myCmp struct{
+//		lines.Component
+//		lines.Decking
+//	}
+//
+//	func (c *myCmp) OnInit(_ *lines.Env) {
+//		c.CC = []lines.Componenter{&page1{}, &page2{}}
+//	}
+//
+//	func (c *myCmp) OnRune(_ *lines.Env, r rune, _ lines.ModifierMask) {
+//		if r == 'n' {
+//			c.Show(c.Current() + 1)
+//		}
+//	}
+//
+// A decked component is initialized once it is shown the first time.
+// Is the focus inside the shown component when an other component is
+// shown the focus moves to the newly shown component.
+type Decking struct {
+
+	// CC holds the decked components
+	CC []Componenter
+
+	current int
+}
+
+// ForDecked calls back for each component of this Decker respectively
+// until the callback asks to stop.
+func (d Decking) ForDecked(cb func(Componenter) (stop bool)) {
+	for _, c := range d.CC {
+		if cb(c) {
+			return
+		}
+	}
+}
+
+// Current returns the index of the shown decked component.
+func (d Decking) Current() int { return d.current }
+
+// Show shows the decked component with given index idx which takes
+// effect once the reported event is processed.  An index outside CC
+// is ignored.
+func (d *Decking) Show(idx int) {
+	if idx < 0 || idx >= len(d.CC) {
+		return
+	}
+	d.current = idx
+}
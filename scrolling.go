@@ -4,6 +4,8 @@
 
 package lines
 
+import "github.com/slukits/lines/internal/lyt"
+
 type ScrollBarDef struct {
 	AtLeft   bool
 	GapIndex int
@@ -15,85 +17,136 @@ func DefaultScrollbarDef() ScrollBarDef {
 	return ScrollBarDef{Style: DefaultStyle.Reverse(), Position: DefaultStyle}
 }
 
-// Scroller provides a component's scrolling API.
+// Scroller provides a component's scrolling API.  The Scroller of a
+// [Viewporter] scrolls the lines of its canvas instead of its content
+// lines whereas its To method scrolls the nested component with given
+// index into view.
 type Scroller struct {
 	c   *Component
 	Bar bool
 	bar int
 }
 
+// viewport returns the layout component of a scrolled viewporter or nil
+// if the scrolled component is no viewporter.
+func (s Scroller) viewport() *viewportWrapper {
+	vw, _ := s.c.layoutCmp.(*viewportWrapper)
+	return vw
+}
+
 // IsAtTop returns true if the first screen line is the first component
 // line.
-func (s Scroller) IsAtTop() bool { return s.c.First() == 0 }
+func (s Scroller) IsAtTop() bool {
+	if vw := s.viewport(); vw != nil {
+		offset, _ := lyt.Scrolled(vw)
+		return offset == 0
+	}
+	return s.c.row() == 0
+}
 
 // IsAtBottom is true if a component's printable area contains the
 // component's last line.
 func (s Scroller) IsAtBottom() bool {
-	return s.c.First()+s.c.ContentScreenLines() >= s.c.Len()
+	if vw := s.viewport(); vw != nil {
+		offset, max := lyt.Scrolled(vw)
+		return offset >= max
+	}
+	return s.c.row()+s.c.ContentScreenLines() >= s.c.rowsLen()
 }
 
 // Up scrolls one page up.  Whereas "one page" is in case of a component
 // height of 1 is one line.  For a height h with 1 < h < 20 "one page"
 // is h - 1.  For h >= 20 "one page" is h - h/10.
 func (s Scroller) Up() {
-	height, scroll := s.c.ContentScreenLines(), 0
-	if height <= 0 || s.c.First() == 0 {
+	if vw := s.viewport(); vw != nil {
+		lyt.ScrollBy(vw, -page(s.c.ContentScreenLines()))
 		return
 	}
-	switch {
-	case height == 1:
-		scroll = 1
-	case height < 20:
-		scroll = height - 1
-	default:
-		scroll = height - (height / 10)
+	height := s.c.ContentScreenLines()
+	if height <= 0 || s.c.row() == 0 {
+		return
+	}
+	scroll := page(height)
+	if scroll >= s.c.row() {
+		scroll = s.c.row()
 	}
-	if scroll >= s.c.First() {
-		scroll = s.c.First()
+	if !s.c.isWrapping() {
+		s.c.LL.Focus.switchScrollingSourcedHighlight(-scroll)
 	}
-	s.c.LL.Focus.switchScrollingSourcedHighlight(-scroll)
-	s.c.setFirst(s.c.First() - scroll)
+	s.c.setRow(s.c.row() - scroll)
 }
 
 // ToTop scrolls a component's content to its first line, i.e. the first
 // screen line displays the first component line.
-func (s Scroller) ToTop() { s.c.setFirst(0) }
+func (s Scroller) ToTop() {
+	if vw := s.viewport(); vw != nil {
+		lyt.ScrollTo(vw, 0)
+		return
+	}
+	s.c.setFirst(0)
+}
 
 // ToBottom scrolls to the index that the last screen line displays the
 // last component line.
 func (s Scroller) ToBottom() {
+	if vw := s.viewport(); vw != nil {
+		_, max := lyt.Scrolled(vw)
+		lyt.ScrollTo(vw, max)
+		return
+	}
 	height := s.c.ContentScreenLines()
 	if height <= 0 {
 		return
 	}
-	s.c.setFirst(s.c.Len() - height)
+	s.c.setRow(s.c.rowsLen() - height)
 }
 
 // Down scrolls one page down.  Whereas "one page" is in case of a
 // component height of 1 is one line.  For a height h with 1 < h < 20
 // "one page" is h - 1.  For h >= 20 "one page" is h - h/10.
 func (s Scroller) Down() {
-	height, scroll := s.c.ContentScreenLines(), 0
-	if height <= 0 || height >= s.c.Len() {
+	if vw := s.viewport(); vw != nil {
+		lyt.ScrollBy(vw, page(s.c.ContentScreenLines()))
+		return
+	}
+	height := s.c.ContentScreenLines()
+	if height <= 0 || height >= s.c.rowsLen() {
 		return
 	}
+	scroll := page(height)
+	if s.c.rowsLen()-(s.c.row()+scroll) < height {
+		scroll = (s.c.rowsLen() - height) - s.c.row()
+	}
+	if !s.c.isWrapping() {
+		s.c.LL.Focus.switchScrollingSourcedHighlight(scroll)
+	}
+	s.c.setRow(s.c.row() + scroll)
+}
+
+// page returns the number of lines "one page" has for given height,
+// see [Scroller.Up].
+func page(height int) int {
 	switch {
-	case height == 1:
-		scroll = 1
+	case height <= 1:
+		return 1
 	case height < 20:
-		scroll = height - 1
-	default:
-		scroll = height - (height / 10)
+		return height - 1
 	}
-	if s.c.Len()-(s.c.First()+scroll) < height {
-		scroll = (s.c.Len() - height) - s.c.First()
-	}
-	s.c.LL.Focus.switchScrollingSourcedHighlight(scroll)
-	s.c.setFirst(s.c.First() + scroll)
+	return height - (height / 10)
 }
 
 // To scrolls to the index that the line with given index is displayed.
+// The line of a wrapping component is scrolled that all its rows are
+// displayed if possible.
 func (s Scroller) To(idx int) {
+	if vw := s.viewport(); vw != nil {
+		scrollToNested(vw, idx)
+		return
+	}
+	if s.c.isWrapping() {
+		s.scrollToRows(idx)
+		return
+	}
 	height := s.c.ContentScreenLines()
 	if height <= 0 {
 		return
@@ -118,6 +171,23 @@ func (s Scroller) To(idx int) {
 	}
 }
 
+// canScroll returns true if given layout component lc can be scrolled
+// in the direction of given scroll feature f.
+func canScroll(lc layoutComponenter, f FeatureMask) bool {
+	if vw, ok := lc.(*viewportWrapper); ok {
+		offset, max := lyt.Scrolled(vw)
+		if f == UpScrollable {
+			return offset > 0
+		}
+		return offset < max
+	}
+	c := lc.wrapped()
+	if f == UpScrollable {
+		return c.row() > 0
+	}
+	return c.row()+c.ContentScreenLines() < c.rowsLen()
+}
+
 func (s Scroller) scrollBarGap(c *component) (
 	w *GapWriter, sbd ScrollBarDef, ww *GapsWriter,
 ) {
@@ -147,22 +217,26 @@ func (s *Scroller) setScrollBar() {
 
 func (s Scroller) BarPosition() int {
 	c := s.c.layoutCmp.wrapped()
+	first, length := c.row(), c.rowsLen()
+	if vw := s.viewport(); vw != nil {
+		length, first = lyt.Canvas(vw)
+	}
 	ll := c.ContentScreenLines()
-	if ll >= c.Len() {
+	if ll >= length {
 		return -1
 	}
-	if c.First() == 0 {
+	if first == 0 {
 		return 0
 	}
-	if c.First()+ll >= c.Len() {
+	if first+ll >= length {
 		return ll - 1
 	}
-	normalizer := c.Len() / ll
+	normalizer := length / ll
 	var shown int
 	if ll > 2 {
-		shown = c.First() + ll/2
+		shown = first + ll/2
 	} else {
-		shown = c.First() + ll
+		shown = first + ll
 	}
 	pos := shown / normalizer
 	if pos+1 >= ll {
@@ -0,0 +1,195 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package lines
+
+import (
+	"github.com/slukits/lines/internal/api"
+	"github.com/slukits/lines/internal/lyt"
+)
+
+// Splits returns the positions of the splits between the nested
+// components of a stacking or chaining component, i.e. the offsets of
+// the bottom respectively right edges of all but its last nested
+// component relative to the beginning of its first nested component.
+// Splits returns nil for a component which is neither stacking nor
+// chaining or which has less than two nested components.  Store the
+// splits of a [Splittable] component to restore them with
+// [Component.SetSplits].
+func (c *component) Splits() []int {
+	if !isSplitting(c.userCmp.layoutComponent()) {
+		return nil
+	}
+	return lyt.Splits(c.userCmp.layoutComponent())
+}
+
+// SetSplits moves the splits between the nested components of a
+// stacking or chaining component to given positions pp, see
+// [Component.Splits].  A split is not moved further than the minimal
+// filling sizes and the maximal sizes of its two adjacent components
+// permit.  Is the component not layed out yet its splits are set after
+// its first layout.
+func (c *component) SetSplits(pp ...int) {
+	if !isSplitting(c.userCmp.layoutComponent()) {
+		return
+	}
+	if _, _, w, h := c.dim.Printable(); w == 0 || h == 0 {
+		c.splits = pp
+		return
+	}
+	lyt.SetSplits(c.userCmp.layoutComponent(), pp...)
+}
+
+// setPendingSplits sets splits requested before given layout
+// component lc was layed out and returns true if there were any.
+func setPendingSplits(lc layoutComponenter) bool {
+	if lc.wrapped().splits == nil {
+		return false
+	}
+	lyt.SetSplits(lc, lc.wrapped().splits...)
+	lc.wrapped().splits = nil
+	return true
+}
+
+// isSplitting returns true if given layout component lc is stacking or
+// chaining.
+func isSplitting(lc layoutComponenter) bool {
+	switch lc.(type) {
+	case *stackingWrapper, *chainingWrapper, *responsiveWrapper:
+		return true
+	}
+	return false
+}
+
+// isStacking returns true if given splitting layout component lc
+// stacks its nested components.
+func isStacking(lc layoutComponenter) bool {
+	switch lc := lc.(type) {
+	case *stackingWrapper:
+		return true
+	case *responsiveWrapper:
+		return !lc.Chaining()
+	}
+	return false
+}
+
+// nestedOf returns the nested components of given stacking or chaining
+// layout component lc.
+func nestedOf(lc layoutComponenter) (nested []layoutComponenter) {
+	add := func(d lyt.Dimer) (stop bool) {
+		nested = append(nested, d.(layoutComponenter))
+		return false
+	}
+	switch lc := lc.(type) {
+	case *stackingWrapper:
+		lc.ForStacked(add)
+	case *chainingWrapper:
+		lc.ForChained(add)
+	case *responsiveWrapper:
+		lc.ForStacked(add)
+	}
+	return nested
+}
+
+// moveFocusedSplit moves the split after the nested component
+// containing the focus of the innermost stacking or chaining ancestor
+// of the focused component having given split feature f set.  Is the
+// focus in the last nested component the split before it is moved.
+func moveFocusedSplit(cntx *rprContext, f FeatureMask, delta int) {
+	var inner layoutComponenter
+	cntx.scr.forFocused(func(lc layoutComponenter) (stop bool) {
+		defer func() { inner = lc }()
+		if inner == nil || !isSplitting(lc) || !lc.wrapped().ff.has(f) {
+			return false
+		}
+		nested := nestedOf(lc)
+		for i, n := range nested {
+			if n != inner {
+				continue
+			}
+			if i == len(nested)-1 {
+				i--
+			}
+			lyt.MoveSplit(lc, i, delta)
+			return true
+		}
+		return false
+	})
+}
+
+// splitDrag is the split of a stacking or chaining layout component
+// which is currently dragged by the user.
+type splitDrag struct {
+	lc  layoutComponenter
+	idx int
+}
+
+// splitDragAt returns the split of the innermost splittable stacking
+// or chaining component whose handle contains given coordinates.  Since
+// a split's handle is part of the split's first component a drag
+// starting at the handle is left to a component nested in the
+// splitting component which implements Drager, i.e. splitDragAt
+// returns nil in this case.
+func splitDragAt(cntx *rprContext, x, y int) *splitDrag {
+	path, err := cntx.scr.lyt.LocateAt(x, y)
+	if err != nil {
+		return nil
+	}
+	for i := len(path) - 1; i >= 0; i-- {
+		lc := path[i].(layoutComponenter)
+		if !isSplitting(lc) || !lc.wrapped().ff.has(splitDraggable) {
+			continue
+		}
+		idx := lyt.SplitAt(lc, x, y)
+		if idx < 0 {
+			continue
+		}
+		for _, d := range path[i+1:] {
+			if _, ok := d.(layoutComponenter).userComponent().(Drager); ok {
+				return nil
+			}
+		}
+		return &splitDrag{lc: lc, idx: idx}
+	}
+	return nil
+}
+
+// dragSplit moves the dragged split to the position of given drag
+// event and returns true if the drag started at the handle of a split
+// of a splittable stacking or chaining component.
+func dragSplit(cntx *rprContext, evt *MouseDrag) bool {
+	if evt.Button()&api.Primary == 0 {
+		return false
+	}
+	sd := cntx.scr.splitDrag
+	if sd == nil {
+		ox, oy := evt.Origin()
+		if sd = splitDragAt(cntx, ox, oy); sd == nil {
+			return false
+		}
+		cntx.scr.splitDrag = sd
+	}
+	nested := nestedOf(sd.lc)
+	if sd.idx >= len(nested)-1 {
+		return true
+	}
+	x, y := evt.Pos()
+	hx, hy, w, h := nested[sd.idx].Dim().Screen()
+	if isStacking(sd.lc) {
+		lyt.MoveSplit(sd.lc, sd.idx, y-(hy+h-1))
+		return true
+	}
+	lyt.MoveSplit(sd.lc, sd.idx, x-(hx+w-1))
+	return true
+}
+
+// dropSplit ends the dragging of a split and returns true if a split
+// was dragged.
+func dropSplit(cntx *rprContext) bool {
+	if cntx.scr.splitDrag == nil {
+		return false
+	}
+	cntx.scr.splitDrag = nil
+	return true
+}
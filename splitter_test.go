@@ -0,0 +1,158 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package lines
+
+import (
+	"testing"
+
+	. "github.com/slukits/gounit"
+)
+
+type _splitter struct{ Suite }
+
+func (s *_splitter) SetUp(t *T) { t.Parallel() }
+
+// splittableStacking returns a splittable stacking of two components.
+func splittableStacking() (*stackingFX, []*cmpFX) {
+	cc := []*cmpFX{{}, {}}
+	stk := &stackingFX{Stacking: Stacking{CC: []Componenter{cc[0], cc[1]}}}
+	stk.onInit = func(c *cmpFX, e *Env) { c.FF.Set(Splittable) }
+	return stk, cc
+}
+
+// splittableChaining returns a splittable chaining of two components.
+func splittableChaining() (*chainingFX, []*cmpFX) {
+	cc := []*cmpFX{{}, {}}
+	chn := &chainingFX{Chaining: Chaining{CC: []Componenter{cc[0], cc[1]}}}
+	chn.onInit = func(c *cmpFX, e *Env) { c.FF.Set(Splittable) }
+	return chn, cc
+}
+
+func (s *_splitter) Drags_stacked_split_to_drop_position(t *T) {
+	stk, cc := splittableStacking()
+	fx := fx(t, stk)
+	_, _, _, h := fx.Dim(cc[0]).Printable()
+	t.Eq(13, h)
+	fx.FireDragNDrop(10, 17, Primary, ZeroModifier, 10, 12)
+	_, _, _, h = fx.Dim(cc[0]).Printable()
+	t.Eq(18, h)
+	_, y, _, h := fx.Dim(cc[1]).Printable()
+	t.True(y == 18 && h == 7)
+}
+
+func (s *_splitter) Drags_chained_split_to_drop_position(t *T) {
+	chn, cc := splittableChaining()
+	fx := fx(t, chn)
+	fx.FireDragNDrop(19, 5, Primary, ZeroModifier, 39, 5)
+	_, _, w, _ := fx.Dim(cc[0]).Printable()
+	t.Eq(20, w)
+	x, _, w, _ := fx.Dim(cc[1]).Printable()
+	t.True(x == 20 && w == 60)
+}
+
+type dragerFX struct {
+	cmpFX
+	dragged bool
+}
+
+func (c *dragerFX) OnDrag(*Env, ButtonMask, int, int) { c.dragged = true }
+
+func (s *_splitter) Reports_drag_not_starting_at_split_handle(t *T) {
+	stk, _ := splittableStacking()
+	drg := &dragerFX{}
+	stk.CC[0] = drg
+	fx := fx(t, stk)
+	fx.FireDragNDrop(10, 5, Primary, ZeroModifier, 10, 3)
+	t.True(drg.dragged)
+	_, _, _, h := fx.Dim(drg).Printable()
+	t.Eq(13, h)
+}
+
+func (s *_splitter) Reports_drag_at_split_handle_to_drager(t *T) {
+	stk, _ := splittableStacking()
+	drg := &dragerFX{}
+	stk.CC[0] = drg
+	fx := fx(t, stk)
+	fx.FireDragNDrop(10, 8, Primary, ZeroModifier, 10, 12)
+	t.True(drg.dragged)
+	_, _, _, h := fx.Dim(drg).Printable()
+	t.Eq(13, h)
+}
+
+func (s *_splitter) Ignores_split_handle_of_unsplittable_stacking(t *T) {
+	cc := []*cmpFX{{}, {}}
+	stk := &stackingFX{Stacking: Stacking{CC: []Componenter{cc[0], cc[1]}}}
+	fx := fx(t, stk)
+	fx.FireDragNDrop(10, 17, Primary, ZeroModifier, 10, 12)
+	_, _, _, h := fx.Dim(cc[0]).Printable()
+	t.Eq(13, h)
+}
+
+func (s *_splitter) Respects_minimal_filling_size_of_neighbour(t *T) {
+	stk, cc := splittableStacking()
+	cc[1].onInit = func(c *cmpFX, e *Env) { c.Dim().SetFilling(1, 10) }
+	fx := fx(t, stk)
+	fx.FireDragNDrop(10, 24, Primary, ZeroModifier, 10, 7)
+	_, _, _, h := fx.Dim(cc[1]).Printable()
+	t.Eq(10, h)
+}
+
+func (s *_splitter) Moves_split_after_focused_component_by_keys(t *T) {
+	stk, cc := splittableStacking()
+	fx := fx(t, stk)
+	t.FatalOn(fx.Lines.Focus(cc[0]))
+	fx.FireKey(Down, Alt).FireKey(Down, Alt)
+	_, _, _, h := fx.Dim(cc[0]).Printable()
+	t.Eq(15, h)
+	t.FatalOn(fx.Lines.Focus(cc[1]))
+	fx.FireKey(Up, Alt)
+	_, _, _, h = fx.Dim(cc[1]).Printable()
+	t.Eq(11, h)
+}
+
+func (s *_splitter) Moves_split_if_only_container_has_feature(t *T) {
+	stk, cc := splittableStacking()
+	fx := fx(t, stk)
+	t.FatalOn(fx.Lines.Update(cc[0], nil, func(e *Env) {
+		cc[0].FF.Delete(Splittable)
+	}))
+	t.FatalOn(fx.Lines.Focus(cc[0]))
+	fx.FireKey(Up, Alt)
+	_, _, _, h := fx.Dim(cc[0]).Printable()
+	t.Eq(12, h)
+}
+
+func (s *_splitter) Provides_and_restores_split_positions(t *T) {
+	stk, _ := splittableStacking()
+	fx := fx(t, stk)
+	var splits []int
+	t.FatalOn(fx.Lines.Update(stk, nil, func(e *Env) {
+		splits = stk.Splits()
+	}))
+	t.Eq([]int{13}, splits)
+	t.FatalOn(fx.Lines.Update(stk, nil, func(e *Env) {
+		stk.SetSplits(5)
+	}))
+	t.FatalOn(fx.Lines.Update(stk, nil, func(e *Env) {
+		splits = stk.Splits()
+	}))
+	t.Eq([]int{5}, splits)
+}
+
+func (s *_splitter) Restores_splits_set_before_first_layout(t *T) {
+	chn, cc := splittableChaining()
+	chn.onInit = func(c *cmpFX, e *Env) { chn.SetSplits(30) }
+	fx := fx(t, chn)
+	_, _, w, _ := fx.Dim(cc[0]).Printable()
+	t.Eq(30, w)
+	fx.FireResize(160, 25)
+	_, _, w, _ = fx.Dim(cc[0]).Printable()
+	t.Eq(60, w)
+}
+
+func TestSplitter(t *testing.T) {
+	t.Parallel()
+	Run(&_splitter{}, t)
+}
@@ -0,0 +1,108 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package lines
+
+import (
+	"errors"
+	"time"
+
+	"github.com/slukits/lines/internal/api"
+)
+
+// ErrNotSuspendable is passed to [Resumer] implementations if the
+// backend of a [Lines] instance doesn't support suspending.
+var ErrNotSuspendable = errors.New("lines: suspend: backend not suspendable")
+
+// Resumer is implemented by components which want to be informed after
+// the screen was resumed from a suspension, see [Lines.Suspend].
+type Resumer interface {
+
+	// OnResume is called back after the function executed during a
+	// suspension returned.  Given error err is the error returned by
+	// this function.  OnResume is reported to the focused component and
+	// bubbles up through all its ancestors unless Env.StopBubbling is
+	// called.
+	OnResume(e *Env, err error)
+}
+
+// Suspend posts a suspend event.  Once it is processed the screen is
+// suspended, i.e. the terminal is reset to the state it had before the
+// Lines instance was created, given function run is executed and the
+// screen is resumed afterwards.  Finally the whole component tree is
+// redrawn while focus, layers and cursor are preserved and [Resumer]
+// implementations are informed.  Note no events are processed while run
+// is executing, e.g.:
+//
+//	func (c *Cmp) OnRune(e *lines.Env, r rune, _ lines.ModifierMask) {
+//	    if r != 'e' {
+//	        return
+//	    }
+//	    e.Lines.Suspend(func() error {
+//	        cmd := exec.Command(os.Getenv("EDITOR"), c.file)
+//	        cmd.Stdin, cmd.Stdout = os.Stdin, os.Stdout
+//	        return cmd.Run()
+//	    })
+//	}
+//
+// Suspend fails if the event-loop is full.  It is a no-op if given
+// function is nil.
+func (ll *Lines) Suspend(run func() error) error {
+	if run == nil {
+		return nil
+	}
+	return ll.backend.Post(&suspendEvent{when: time.Now(), run: run})
+}
+
+// suspendEvent is posted by calling Suspend.  This event-instance is
+// not provided to the user.
+type suspendEvent struct {
+	when time.Time
+	run  func() error
+}
+
+func (e *suspendEvent) When() time.Time { return e.when }
+
+func (e *suspendEvent) Source() interface{} { return e }
+
+// suspend suspends the backend, executes given event evt's function
+// and resumes the backend to hard-sync the component tree.
+func (ll *Lines) suspend(evt *suspendEvent) {
+	s, ok := ll.backend.(api.Suspender)
+	if !ok {
+		ll.reportResume(evt, ErrNotSuspendable)
+		return
+	}
+	x, y := ll.scr.cursor.Coordinates()
+	cs := ll.scr.cursor.Style()
+	if err := s.Suspend(); err != nil {
+		ll.reportResume(evt, err)
+		return
+	}
+	err := evt.run()
+	if rErr := s.Resume(); rErr != nil && err == nil {
+		err = rErr
+	}
+	ll.scr.hardSync(ll)
+	if x >= 0 && y >= 0 {
+		ll.scr.setCursor(x, y, cs)
+	}
+	ll.reportResume(evt, err)
+}
+
+func (ll *Lines) reportResume(evt *suspendEvent, err error) {
+	cntx := &rprContext{evt: evt, ll: ll, scr: ll.scr}
+	ll.scr.forFocused(func(c layoutComponenter) (stop bool) {
+		rsm, ok := c.userComponent().(Resumer)
+		if !ok {
+			return false
+		}
+		env := callback(c.userComponent(), cntx, func(e *Env) {
+			rsm.OnResume(e, err)
+		})
+		return env&envStopBubbling == envStopBubbling
+	})
+	reportInit(ll, ll.scr)
+	ll.scr.softSync(ll)
+}
@@ -0,0 +1,155 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package lines
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/slukits/lines/internal/api"
+)
+
+// Go runs given function work in its own go routine, i.e. off the
+// event loop, and reports its result to given function onDone inside
+// the event loop.  onDone is called with an environment for given
+// componenter cmp or for the focused component if cmp is nil, i.e.
+// onDone may safely access cmp's Component features:
+//
+//	func (c *Cmp) OnInit(e *lines.Env) {
+//	    e.Lines.Go(c, func(ctx context.Context) (any, error) {
+//	        return fetch(ctx, c.url) // no Component access here
+//	    }, func(e *lines.Env, v any, err error) {
+//	        if err != nil {
+//	            fmt.Fprintf(e, "error: %v", err)
+//	            return
+//	        }
+//	        fmt.Fprint(e, v)
+//	    })
+//	}
+//
+// The context passed to work is canceled if the Lines instance is quit,
+// if given component cmp is removed from the layout or if the returned
+// cancel function is called.  onDone is not called if cmp was removed
+// from the layout or the Lines instance was quit before the result was
+// reported.
+func (ll *Lines) Go(
+	cmp Componenter,
+	work func(context.Context) (any, error),
+	onDone func(*Env, any, error),
+) context.CancelFunc {
+	if work == nil {
+		return func() {}
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	t := &task{cmp: cmp, cancel: cancel, onDone: onDone}
+	ll.tasks.add(t)
+	go func() {
+		v, err := work(ctx)
+		pErr := ll.backend.Post(&taskEvent{
+			when: time.Now(), task: t, value: v, err: err})
+		if pErr != nil {
+			ll.tasks.remove(t)
+			cancel()
+		}
+	}()
+	return cancel
+}
+
+// task is a function run by Lines.Go whose result is reported to a
+// component.
+type task struct {
+	cmp    Componenter
+	cancel context.CancelFunc
+	onDone func(*Env, any, error)
+}
+
+// taskEvent is posted by a task's go routine once its function
+// returned.  This event-instance is not provided to the user.
+type taskEvent struct {
+	when  time.Time
+	task  *task
+	value any
+	err   error
+}
+
+func (e *taskEvent) When() time.Time { return e.when }
+
+func (e *taskEvent) Source() interface{} { return e }
+
+// tasks keeps track of the running tasks of a Lines instance.
+type tasks struct {
+	*sync.Mutex
+	tt map[*task]bool
+}
+
+func newTasks(backend api.EventProcessor) *tasks {
+	tt := &tasks{Mutex: &sync.Mutex{}, tt: map[*task]bool{}}
+	backend.OnQuit(tt.cancelAll)
+	return tt
+}
+
+func (tt *tasks) add(t *task) {
+	tt.Lock()
+	defer tt.Unlock()
+	tt.tt[t] = true
+}
+
+// remove removes given task t and returns true iff t was running.
+func (tt *tasks) remove(t *task) bool {
+	tt.Lock()
+	defer tt.Unlock()
+	if !tt.tt[t] {
+		return false
+	}
+	delete(tt.tt, t)
+	return true
+}
+
+// cancelAll cancels all running tasks.
+func (tt *tasks) cancelAll() {
+	tt.Lock()
+	defer tt.Unlock()
+	for t := range tt.tt {
+		t.cancel()
+		delete(tt.tt, t)
+	}
+}
+
+// prune cancels all running tasks whose component is not part of the
+// layout of given screen anymore.
+func (tt *tasks) prune(scr *screen) {
+	tt.Lock()
+	running := make([]*task, 0, len(tt.tt))
+	for t := range tt.tt {
+		if t.cmp != nil {
+			running = append(running, t)
+		}
+	}
+	tt.Unlock()
+	for _, t := range running {
+		if scr.isInLayout(t.cmp) {
+			continue
+		}
+		if tt.remove(t) {
+			t.cancel()
+		}
+	}
+}
+
+// reportTask reports the result of a task to its onDone listener.
+func reportTask(cntx *rprContext, evt *taskEvent) {
+	t := evt.task
+	if !cntx.ll.tasks.remove(t) {
+		return
+	}
+	t.cancel()
+	if t.onDone == nil {
+		return
+	}
+	callback(t.cmp, cntx, func(e *Env) {
+		t.onDone(e, evt.value, evt.err)
+	})
+}
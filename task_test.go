@@ -0,0 +1,109 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package lines
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	. "github.com/slukits/gounit"
+)
+
+type _task struct{ Suite }
+
+func (s *_task) SetUp(t *T) { t.Parallel() }
+
+func waitFor(t *T, c <-chan struct{}) {
+	t.GoT().Helper()
+	select {
+	case <-c:
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed out")
+	}
+}
+
+func (s *_task) Reports_result_to_given_component(t *T) {
+	fx, cmp := fxCmp(t)
+	done := make(chan struct{})
+	fx.Lines.Go(cmp, func(context.Context) (any, error) {
+		return "task done", nil
+	}, func(e *Env, v any, err error) {
+		t.FatalOn(err)
+		fmt.Fprint(e, v)
+		close(done)
+	})
+	waitFor(t, done)
+	t.FatalOn(fx.Lines.Update(cmp, nil, func(*Env) {}))
+	t.Eq("task done", fx.ScreenOf(cmp).Trimmed().String())
+}
+
+func (s *_task) Reports_error_of_work(t *T) {
+	fx, cmp := fxCmp(t)
+	done, expErr := make(chan struct{}), errors.New("failed")
+	var got error
+	fx.Lines.Go(cmp, func(context.Context) (any, error) {
+		return nil, expErr
+	}, func(e *Env, v any, err error) {
+		got = err
+		close(done)
+	})
+	waitFor(t, done)
+	t.True(got == expErr)
+}
+
+func (s *_task) Cancels_context_on_quit(t *T) {
+	fx, cmp := fxCmp(t)
+	canceled, reported := make(chan struct{}), false
+	fx.Lines.Go(cmp, func(ctx context.Context) (any, error) {
+		<-ctx.Done()
+		close(canceled)
+		return nil, ctx.Err()
+	}, func(*Env, any, error) { reported = true })
+	fx.Lines.Quit()
+	waitFor(t, canceled)
+	t.Not.True(reported)
+}
+
+func (s *_task) Cancels_context_on_component_removal(t *T) {
+	kept, removed := &cmpFX{}, &cmpFX{}
+	root := &stackingFX{Stacking: Stacking{CC: []Componenter{
+		kept, removed}}}
+	fx := fx(t, root)
+	var keptCtx, removedCtx context.Context
+	started, reported := make(chan struct{}, 2), false
+	work := func(ctx *context.Context) func(context.Context) (any, error) {
+		return func(c context.Context) (any, error) {
+			*ctx = c
+			started <- struct{}{}
+			<-c.Done()
+			return nil, c.Err()
+		}
+	}
+	onDone := func(*Env, any, error) { reported = true }
+	cancelKept := fx.Lines.Go(kept, work(&keptCtx), onDone)
+	fx.Lines.Go(removed, work(&removedCtx), onDone)
+	<-started
+	<-started
+
+	t.FatalOn(fx.Lines.Update(root, nil, func(*Env) {}))
+	t.FatalOn(removedCtx.Err())
+
+	t.FatalOn(fx.Lines.Update(root, nil, func(*Env) {
+		root.CC = root.CC[:1]
+	}))
+	t.ErrIs(removedCtx.Err(), context.Canceled)
+	t.FatalOn(keptCtx.Err())
+	t.Not.True(reported)
+	cancelKept()
+	t.ErrIs(keptCtx.Err(), context.Canceled)
+}
+
+func TestTask(t *testing.T) {
+	t.Parallel()
+	Run(&_task{}, t)
+}
@@ -0,0 +1,240 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package lines
+
+import (
+	"sync"
+	"time"
+
+	"github.com/slukits/lines/internal/api"
+)
+
+// Timer is returned by [Lines.After] and [Lines.Tick] and allows to
+// cancel a scheduled timer respectively ticker event.  A Timer's
+// listener is executed inside the event loop like an [Lines.Update]
+// listener, i.e. it may safely access the component it was registered
+// for.  Note all timers of a [Lines] instance are stopped on quitting.
+type Timer struct {
+	tt       *timers
+	cmp      Componenter
+	lst      Listener
+	interval time.Duration
+
+	// due is the point in time of the next timeout if timers are driven
+	// by a fixture.
+	due time.Time
+
+	// tmr is the backing timer if timers are driven by the system
+	// clock.
+	tmr *time.Timer
+
+	stopped bool
+}
+
+// Stop cancels given timer t.  I.e. its listener won't be called
+// anymore even if a timer event was already posted but not yet
+// processed.  Stop returns false if t was already stopped or if its
+// listener was already called for a timer created by [Lines.After].
+func (t *Timer) Stop() bool {
+	if t == nil {
+		return false
+	}
+	return t.tt.stop(t)
+}
+
+// IsTicker returns true if given timer t was created by [Lines.Tick].
+func (t *Timer) IsTicker() bool { return t.interval > 0 }
+
+func (t *Timer) isStopped() bool {
+	t.tt.Lock()
+	defer t.tt.Unlock()
+	return t.stopped
+}
+
+// TimerEvent is reported to a [Lines.After] or [Lines.Tick] listener
+// once its timer expires:
+//
+//	ll.Tick(c, time.Second, func(e *lines.Env) {
+//	    if done {
+//	        e.Evt.(*lines.TimerEvent).Timer.Stop()
+//	    }
+//	})
+type TimerEvent struct {
+	when time.Time
+
+	// Timer is the timer which triggered the event.
+	Timer *Timer
+}
+
+// When of a timer event is the point in time the timer expired.
+func (e *TimerEvent) When() time.Time { return e.when }
+
+func (e *TimerEvent) Source() interface{} { return e }
+
+// timers keeps track of the running timers of a Lines instance to stop
+// them on quitting.  If timers are manually driven no system timers are
+// started; instead the due timers are posted by advancing the clock.
+type timers struct {
+	*sync.Mutex
+	backend api.EventProcessor
+	tt      map[*Timer]bool
+	manual  bool
+	now     time.Time
+	quit    bool
+}
+
+func newTimers(backend api.EventProcessor, manual bool) *timers {
+	tt := &timers{
+		Mutex:   &sync.Mutex{},
+		backend: backend,
+		tt:      map[*Timer]bool{},
+		manual:  manual,
+		now:     time.Now(),
+	}
+	backend.OnQuit(tt.stopAll)
+	return tt
+}
+
+// After calls back given listener l after given duration d has passed
+// in the event loop.  The listener is called with an environment for
+// given componenter cmp or for the focused component if cmp is nil.
+// The returned Timer may be used to cancel the call.
+func (ll *Lines) After(cmp Componenter, d time.Duration, l Listener) *Timer {
+	return ll.timers.start(cmp, d, 0, l)
+}
+
+// Tick calls back given listener l every given interval in the event
+// loop until the returned Timer is stopped or the Lines instance is
+// quit.  The listener is called with an environment for given
+// componenter cmp or for the focused component if cmp is nil.  Tick
+// returns nil if given interval is not positive or l is nil.
+func (ll *Lines) Tick(
+	cmp Componenter, interval time.Duration, l Listener,
+) *Timer {
+	if interval <= 0 {
+		return nil
+	}
+	return ll.timers.start(cmp, interval, interval, l)
+}
+
+func (tt *timers) start(
+	cmp Componenter, d, interval time.Duration, l Listener,
+) *Timer {
+	if l == nil {
+		return nil
+	}
+	t := &Timer{tt: tt, cmp: cmp, lst: l, interval: interval}
+	tt.Lock()
+	defer tt.Unlock()
+	if tt.quit {
+		t.stopped = true
+		return t
+	}
+	tt.tt[t] = true
+	if tt.manual {
+		t.due = tt.now.Add(d)
+		return t
+	}
+	t.tmr = time.AfterFunc(d, func() { tt.expire(t) })
+	return t
+}
+
+// expire posts the timer event for given timer t and reschedules t if
+// it is a ticker.
+func (tt *timers) expire(t *Timer) {
+	tt.Lock()
+	if t.stopped {
+		tt.Unlock()
+		return
+	}
+	if t.interval > 0 && !tt.manual {
+		t.tmr.Reset(t.interval)
+	}
+	tt.Unlock()
+	tt.backend.Post(&TimerEvent{when: time.Now(), Timer: t})
+}
+
+func (tt *timers) stop(t *Timer) bool {
+	tt.Lock()
+	defer tt.Unlock()
+	if t.stopped {
+		return false
+	}
+	t.stopped = true
+	delete(tt.tt, t)
+	if t.tmr != nil {
+		t.tmr.Stop()
+	}
+	return true
+}
+
+func (tt *timers) stopAll() {
+	tt.Lock()
+	defer tt.Unlock()
+	tt.quit = true
+	for t := range tt.tt {
+		t.stopped = true
+		if t.tmr != nil {
+			t.tmr.Stop()
+		}
+	}
+	tt.tt = map[*Timer]bool{}
+}
+
+// advance moves the clock of manually driven timers by given duration
+// d and posts timer events for all timers expiring in that time span
+// in the order of their expiration.  advance stops at the first failing
+// post returning its error.
+func (tt *timers) advance(d time.Duration) error {
+	tt.Lock()
+	end := tt.now.Add(d)
+	tt.Unlock()
+	for {
+		tt.Lock()
+		next := tt.nextDue(end)
+		if next == nil {
+			tt.now = end
+			tt.Unlock()
+			return nil
+		}
+		tt.now = next.due
+		now := tt.now
+		if next.interval > 0 {
+			next.due = next.due.Add(next.interval)
+		} else {
+			delete(tt.tt, next)
+		}
+		tt.Unlock()
+		err := tt.backend.Post(&TimerEvent{when: now, Timer: next})
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// nextDue returns the timer with the earliest due time not after given
+// end or nil if there is no such timer.
+func (tt *timers) nextDue(end time.Time) (next *Timer) {
+	for t := range tt.tt {
+		if t.due.After(end) {
+			continue
+		}
+		if next == nil || t.due.Before(next.due) {
+			next = t
+		}
+	}
+	return next
+}
+
+func reportTimer(cntx *rprContext, evt *TimerEvent) {
+	t := evt.Timer
+	if t.isStopped() {
+		return
+	}
+	if t.interval == 0 {
+		t.tt.stop(t)
+	}
+	callback(t.cmp, cntx, t.lst)
+}
@@ -0,0 +1,104 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package lines
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	. "github.com/slukits/gounit"
+)
+
+type _timer struct{ Suite }
+
+func (s *_timer) SetUp(t *T) { t.Parallel() }
+
+func (s *_timer) Reports_after_timeout_to_given_listener(t *T) {
+	fx, cmp := fxCmp(t)
+	reported := 0
+	fx.Lines.After(cmp, time.Second, func(e *Env) {
+		reported++
+		fmt.Fprint(e, "timed out")
+	})
+	fx.Advance(999 * time.Millisecond)
+	t.Eq(0, reported)
+	fx.Advance(time.Millisecond)
+	t.Eq(1, reported)
+	t.Eq("timed out", fx.ScreenOf(cmp).Trimmed().String())
+	fx.Advance(time.Hour)
+	t.Eq(1, reported)
+}
+
+func (s *_timer) Reports_ticks_until_stopped(t *T) {
+	fx, cmp := fxCmp(t)
+	ticks := 0
+	tkr := fx.Lines.Tick(cmp, time.Second, func(e *Env) {
+		ticks++
+		fmt.Fprintf(e, "tick %d", ticks)
+	})
+	t.True(tkr.IsTicker())
+	fx.Advance(3 * time.Second)
+	t.Eq(3, ticks)
+	t.Eq("tick 3", fx.ScreenOf(cmp).Trimmed().String())
+	t.True(tkr.Stop())
+	t.Not.True(tkr.Stop())
+	fx.Advance(3 * time.Second)
+	t.Eq(3, ticks)
+}
+
+func (s *_timer) Ticker_may_be_stopped_by_its_listener(t *T) {
+	fx, cmp := fxCmp(t)
+	ticks := 0
+	fx.Lines.Tick(cmp, time.Second, func(e *Env) {
+		ticks++
+		if ticks == 2 {
+			e.Evt.(*TimerEvent).Timer.Stop()
+		}
+	})
+	fx.Advance(5 * time.Second)
+	t.Eq(2, ticks)
+}
+
+func (s *_timer) Reports_timers_in_order_of_expiration(t *T) {
+	fx, cmp := fxCmp(t)
+	got := ""
+	fx.Lines.After(cmp, 3*time.Second, func(e *Env) { got += "c" })
+	fx.Lines.Tick(cmp, 2*time.Second, func(e *Env) { got += "b" })
+	fx.Lines.After(cmp, time.Second, func(e *Env) { got += "a" })
+	fx.Advance(4 * time.Second)
+	t.Eq("abcb", got)
+}
+
+func (s *_timer) Does_not_report_stopped_timer(t *T) {
+	fx, cmp := fxCmp(t)
+	reported := false
+	tmr := fx.Lines.After(cmp, time.Second, func(e *Env) {
+		reported = true
+	})
+	t.True(tmr.Stop())
+	fx.Advance(time.Second)
+	t.Not.True(reported)
+}
+
+func (s *_timer) Are_stopped_on_quit(t *T) {
+	fx, cmp := fxCmp(t)
+	tkr := fx.Lines.Tick(cmp, time.Second, func(e *Env) {})
+	fx.Lines.Quit()
+	t.Not.True(tkr.Stop())
+	tmr := fx.Lines.After(cmp, time.Second, func(e *Env) {})
+	t.Not.True(tmr.Stop())
+}
+
+func (s *_timer) Ticker_is_nil_for_non_positive_interval(t *T) {
+	fx, cmp := fxCmp(t)
+	t.True(fx.Lines.Tick(cmp, 0, func(e *Env) {}) == nil)
+	t.True(fx.Lines.After(cmp, time.Second, nil) == nil)
+}
+
+func TestTimer(t *testing.T) {
+	t.Parallel()
+	Run(&_timer{}, t)
+}
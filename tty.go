@@ -0,0 +1,77 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package lines
+
+import (
+	"io"
+	"sync"
+
+	"github.com/slukits/lines/internal/term"
+)
+
+// Tty is a terminal a [Lines] instance created by [TermOn] is running
+// on.  Use [NewTty] to obtain a Tty for a read-writer like a pty-file
+// or a ssh-channel.
+type Tty interface {
+
+	// Start activates the Tty, i.e. puts a terminal into raw mode.
+	Start() error
+
+	// Stop restores the state the Tty had before Start was called.
+	Stop() error
+
+	// Drain makes a blocking Read return; it is called before Stop.
+	Drain() error
+
+	// NotifyResize registers given callback which is called if the
+	// Tty's window size changes.
+	NotifyResize(cb func())
+
+	// WindowSize returns the Tty's current size.
+	WindowSize() (width, height int, err error)
+
+	io.ReadWriteCloser
+}
+
+// WindowSize is the size of a terminal window in cells, see [NewTty].
+type WindowSize = term.WindowSize
+
+// NewTty returns a [Tty] reading from and writing to given read-writer
+// rw.  Is rw a terminal file, e.g. the slave of a pty, it is put into
+// raw mode while the Tty is started.  The Tty's window size is the
+// latest size received from given channel sizes, i.e. sending a size
+// to this channel makes the Lines instance running on the Tty report a
+// resize event.  Has no size been received yet the size of a terminal
+// file or, if not available, 80x25 is reported.  The returned Tty
+// doesn't close rw.
+func NewTty(rw io.ReadWriter, sizes <-chan WindowSize) Tty {
+	return term.NewTty(rw, sizes)
+}
+
+// TermOn returns a Lines instance like [Term] which is running on given
+// tty instead of the process's terminal.  Many Lines instances created
+// by TermOn may coexist in one process, each having its own
+// event-loop, [Globals] and quit handling, e.g. to serve a lines
+// application to several ssh-sessions:
+//
+//	sizes := make(chan lines.WindowSize, 1)
+//	sizes <- lines.WindowSize{Width: 80, Height: 24}
+//	ll := lines.TermOn(
+//		lines.NewTty(sshChannel, sizes), ptyRequest.Term, &App{})
+//	// forward window-change requests to sizes
+//	ll.WaitForQuit()
+//
+// The terminal's capabilities are looked up for given terminal name,
+// i.e. the TERM of the remote terminal, whereas an empty name defaults
+// to the TERM environment variable of the process.  TermOn
+// panics if the terminal is unknown or the screen can't be obtained.
+func TermOn(tty Tty, name string, cmp Componenter) *Lines {
+	ui, ll := term.NewOn(tty, name, nil), &Lines{}
+	ll.init(ui, cmp, false)
+	ll.Quitting = &quitting{Mutex: &sync.Mutex{}}
+	ll.Quitting.AddRune('q')
+	ui.Listen(ll.listen)
+	return ll
+}
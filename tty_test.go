@@ -0,0 +1,192 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+//go:build linux
+
+package lines
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"syscall"
+	"testing"
+	"time"
+	"unsafe"
+
+	. "github.com/slukits/gounit"
+)
+
+// ptyFX provides a pseudo terminal whose slave a Lines instance runs
+// on while its master emulates the user's terminal.
+type ptyFX struct {
+	master, slave *os.File
+	sizes         chan WindowSize
+	mutex         sync.Mutex
+	out           strings.Builder
+}
+
+func newPtyFX(t *T) *ptyFX {
+	master, err := os.OpenFile("/dev/ptmx", os.O_RDWR, 0)
+	if err != nil {
+		t.GoT().Skipf("can't open pty: %v", err)
+	}
+	unlock, n := 0, uint32(0)
+	t.FatalOn(ioctl(master, syscall.TIOCSPTLCK, unsafe.Pointer(&unlock)))
+	t.FatalOn(ioctl(master, syscall.TIOCGPTN, unsafe.Pointer(&n)))
+	slave, err := os.OpenFile(fmt.Sprintf("/dev/pts/%d", n),
+		os.O_RDWR|syscall.O_NOCTTY, 0)
+	t.FatalOn(err)
+	fx := &ptyFX{master: master, slave: slave,
+		sizes: make(chan WindowSize, 1)}
+	fx.sizes <- WindowSize{Width: 40, Height: 10}
+	go fx.read()
+	t.GoT().Cleanup(func() { slave.Close(); master.Close() })
+	return fx
+}
+
+func ioctl(f *os.File, request uintptr, arg unsafe.Pointer) error {
+	_, _, errno := syscall.Syscall(
+		syscall.SYS_IOCTL, f.Fd(), request, uintptr(arg))
+	if errno != 0 {
+		return errno
+	}
+	return nil
+}
+
+// read collects the output written to the slave.
+func (fx *ptyFX) read() {
+	bb := make([]byte, 1024)
+	for {
+		n, err := fx.master.Read(bb)
+		fx.mutex.Lock()
+		fx.out.Write(bb[:n])
+		fx.mutex.Unlock()
+		if err != nil {
+			return
+		}
+	}
+}
+
+func (fx *ptyFX) String() string {
+	fx.mutex.Lock()
+	defer fx.mutex.Unlock()
+	return fx.out.String()
+}
+
+// displays waits for given string s to be written to the slave.
+func (fx *ptyFX) displays(s string) bool {
+	deadline := time.Now().Add(2 * time.Second)
+	for time.Now().Before(deadline) {
+		if strings.Contains(fx.String(), s) {
+			return true
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	return false
+}
+
+// lines returns a Lines instance running on the fixture's slave.
+func (fx *ptyFX) lines(t *T, cmp Componenter) *Lines {
+	ll := TermOn(NewTty(fx.slave, fx.sizes), "xterm", cmp)
+	t.GoT().Cleanup(func() { ll.Quit(); ll.WaitForQuit() })
+	return ll
+}
+
+type termOn struct{ Suite }
+
+func (s *termOn) SetUp(t *T) { t.Parallel() }
+
+func (s *termOn) Panics_on_an_unknown_terminal(t *T) {
+	fx := newPtyFX(t)
+	t.Panics(func() {
+		TermOn(NewTty(fx.slave, fx.sizes), "no-such-terminal", &cmpFX{})
+	})
+}
+
+func (s *termOn) Displays_instances_independently(t *T) {
+	fx1, fx2 := newPtyFX(t), newPtyFX(t)
+	fx1.lines(t, &cmpFX{onInit: func(_ *cmpFX, e *Env) {
+		fmt.Fprint(e, "first")
+	}})
+	fx2.lines(t, &cmpFX{onInit: func(_ *cmpFX, e *Env) {
+		fmt.Fprint(e, "second")
+	}})
+	t.True(fx1.displays("first"))
+	t.True(fx2.displays("second"))
+	t.Not.Contains(fx1.String(), "second")
+	t.Not.Contains(fx2.String(), "first")
+}
+
+func (s *termOn) Reports_input_to_its_instance_only(t *T) {
+	fx1, fx2 := newPtyFX(t), newPtyFX(t)
+	runes1, runes2 := make(chan rune, 1), make(chan rune, 1)
+	fx1.lines(t, &cmpFX{
+		onRune: func(_ *cmpFX, _ *Env, r rune, _ ModifierMask) {
+			runes1 <- r
+		}})
+	fx2.lines(t, &cmpFX{
+		onRune: func(_ *cmpFX, _ *Env, r rune, _ ModifierMask) {
+			runes2 <- r
+		}})
+	_, err := fx2.master.Write([]byte("x"))
+	t.FatalOn(err)
+	select {
+	case r := <-runes2:
+		t.Eq('x', r)
+	case <-time.After(2 * time.Second):
+		t.Fatal("expected rune reported to second instance")
+	}
+	select {
+	case <-runes1:
+		t.Error("unexpected rune reported to first instance")
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func (s *termOn) Quits_an_instance_without_quitting_others(t *T) {
+	fx1, fx2 := newPtyFX(t), newPtyFX(t)
+	ll1 := fx1.lines(t, &cmpFX{})
+	cmp2 := &cmpFX{}
+	ll2 := fx2.lines(t, cmp2)
+	_, err := fx1.master.Write([]byte("q"))
+	t.FatalOn(err)
+	quitted := make(chan bool)
+	go func() { ll1.WaitForQuit(); close(quitted) }()
+	select {
+	case <-quitted:
+	case <-time.After(2 * time.Second):
+		t.Fatal("expected first instance to quit")
+	}
+	t.FatalOn(ll2.Update(cmp2, nil, func(e *Env) {
+		fmt.Fprint(e, "still-running")
+	}))
+	t.True(fx2.displays("still-running"))
+}
+
+func (s *termOn) Reports_received_window_sizes(t *T) {
+	fx := newPtyFX(t)
+	widths := make(chan int, 8)
+	fx.lines(t, &cmpFX{onLayout: func(c *cmpFX, _ *Env) {
+		widths <- c.Dim().Width()
+	}})
+	fx.sizes <- WindowSize{Width: 30, Height: 10}
+	deadline := time.After(2 * time.Second)
+	for {
+		select {
+		case width := <-widths:
+			if width == 30 {
+				return
+			}
+		case <-deadline:
+			t.Fatal("expected layout for received window size")
+		}
+	}
+}
+
+func TestTermOn(t *testing.T) {
+	t.Parallel()
+	Run(&termOn{}, t)
+}
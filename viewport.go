@@ -0,0 +1,172 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package lines
+
+import (
+	"github.com/slukits/lines/internal/api"
+	"github.com/slukits/lines/internal/lyt"
+)
+
+// Viewporter is implemented by components which want to provide nested
+// components which are vertically stacked on a virtual canvas which
+// may be higher than the viewporter's screen area.  On the canvas each
+// nested component has its fixed height respectively its minimal
+// filling height.  A viewporter displays the part of its canvas which
+// starts with the canvas line it is scrolled to, i.e. a nested
+// component may be partially scrolled out at the top or the bottom.  A
+// viewporter is scrolled by its [Scroller] which is triggered by the
+// features UpScrollable and DownScrollable and the mouse wheel.  A
+// nested component which receives the focus is scrolled into view.
+// Note a viewporter scrolls only vertically, i.e. the canvas has the
+// width of the viewporter and wider nested components are truncated.
+type Viewporter interface {
+
+	// ForViewported calls back for each component of this Viewporter
+	// until the callback asks to stop.
+	ForViewported(func(Componenter) (stop bool))
+}
+
+// Viewporting embedded in a component makes the component implement
+// the Viewporter interface.  Typically the Componenter slice CC is
+// filled in a component's OnInit-listener:
+//
+//	type settings struct{
+//		lines.Component
+//		lines.Viewporting
+//	}
+//
+//	func (c *settings) OnInit(_ *lines.Env) {
+//		for _, s := range c.options {
+//			c.CC = append(c.CC, &option{s: s})
+//		}
+//		c.FF.Set(lines.Scrollable)
+//		c.Scroll.Bar = true
+//	}
+type Viewporting struct {
+
+	// CC holds the components stacked on the canvas.
+	CC []Componenter
+}
+
+// ForViewported calls back for each component of this Viewporter
+// respectively until the callback asks to stop.
+func (v Viewporting) ForViewported(cb func(Componenter) (stop bool)) {
+	for _, c := range v.CC {
+		if cb(c) {
+			return
+		}
+	}
+}
+
+// viewportWrapper wraps a viewporting user-component for the layout
+// manager which lays out its nested components starting with the
+// canvas line it is scrolled to.
+type viewportWrapper struct {
+	*component
+
+	// offset is the canvas line which is displayed first.
+	offset int
+}
+
+func (vw *viewportWrapper) Gaps() api.Gaps {
+	if vw.gaps == nil && vw.Scroll.Bar {
+		vw.Scroll.setScrollBar()
+	}
+	return gapsOf(vw.component)
+}
+
+func (vw *viewportWrapper) ForStacked(cb func(lyt.Dimer) bool) {
+	vw.forLayoutNested(cb)
+}
+
+func (vw *viewportWrapper) Offset() int { return vw.offset }
+
+func (vw *viewportWrapper) SetOffset(o int) {
+	vw.offset = o
+	vw.SetDirty()
+}
+
+// indexOf returns the index of given nested layout component lc of a
+// viewport or -1 if lc is not nested.
+func (vw *viewportWrapper) indexOf(lc lyt.Dimer) int {
+	idx, i := -1, 0
+	vw.ForStacked(func(d lyt.Dimer) (stop bool) {
+		if d == lc {
+			idx = i
+			return true
+		}
+		i++
+		return false
+	})
+	return idx
+}
+
+// scrollToNested scrolls given viewport vw to have its nested component
+// with given index idx displayed.
+func scrollToNested(vw *viewportWrapper, idx int) {
+	i := -1
+	vw.ForStacked(func(d lyt.Dimer) (stop bool) {
+		i++
+		if i != idx {
+			return false
+		}
+		lyt.ScrollIntoView(vw, d)
+		return true
+	})
+}
+
+// scrollIntoView scrolls every viewport of given path of layout
+// components to the following layout component in the path.
+func scrollIntoView(path []lyt.Dimer) {
+	for i, d := range path[:len(path)-1] {
+		if vw, ok := d.(*viewportWrapper); ok {
+			lyt.ScrollIntoView(vw, path[i+1])
+		}
+	}
+}
+
+// readingOrder is the position of a focus movable component in the
+// order of focus movement.  A component nested in a viewport is
+// ordered by the viewport's screen position followed by the index of
+// the viewport's nested component containing it.
+type readingOrder struct{ y, x, idx, nestedY, nestedX int }
+
+func (r readingOrder) less(o readingOrder) bool {
+	for _, c := range [][2]int{{r.y, o.y}, {r.x, o.x}, {r.idx, o.idx},
+		{r.nestedY, o.nestedY}, {r.nestedX, o.nestedX}} {
+		if c[0] != c[1] {
+			return c[0] < c[1]
+		}
+	}
+	return false
+}
+
+// readingOrderOf returns the reading order of given layout component
+// lc of given layout m and false if lc is neither on-screen nor
+// scrolled out of a viewport which is on-screen.
+func readingOrderOf(
+	m *lyt.Manager, lc layoutComponenter,
+) (readingOrder, bool) {
+	path, _ := m.Locate(lc)
+	path = append(path, lc)
+	for i, d := range path[:len(path)-1] {
+		vw, ok := d.(*viewportWrapper)
+		if !ok {
+			continue
+		}
+		if vw.dim.IsOffScreen() {
+			return readingOrder{}, false
+		}
+		ro := readingOrder{idx: vw.indexOf(path[i+1])}
+		ro.x, ro.y, _, _ = vw.dim.Screen()
+		ro.nestedX, ro.nestedY, _, _ = lc.Dim().Screen()
+		return ro, true
+	}
+	if lc.Dim().IsOffScreen() {
+		return readingOrder{}, false
+	}
+	x, y, _, _ := lc.Dim().Screen()
+	return readingOrder{y: y, x: x}, true
+}
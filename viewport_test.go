@@ -0,0 +1,133 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package lines
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	. "github.com/slukits/gounit"
+)
+
+type _viewport struct{ Suite }
+
+func (s *_viewport) SetUp(t *T) { t.Parallel() }
+
+// viewporter returns a scrollable viewport stacking five focus movable
+// components of height ten, i.e. its canvas is twice as high as the
+// screen.
+func viewporter() (*viewportingFX, []*cmpFX) {
+	vp, cc := &viewportingFX{}, []*cmpFX{}
+	vp.onInit = func(c *cmpFX, e *Env) { c.FF.Set(Scrollable) }
+	for i := 0; i < 5; i++ {
+		i := i
+		c := &cmpFX{onInit: func(c *cmpFX, e *Env) {
+			c.Dim().SetHeight(10)
+			c.FF.Set(FocusMovable)
+			fmt.Fprintf(e, "nested %d", i)
+		}}
+		cc, vp.CC = append(cc, c), append(vp.CC, c)
+	}
+	return vp, cc
+}
+
+func (s *_viewport) Lays_out_nested_exceeding_its_height(t *T) {
+	vp, cc := viewporter()
+	fx := fx(t, vp)
+	_, y, _, h := fx.Dim(cc[2]).Printable()
+	t.True(y == 20 && h == 5)
+	t.True(fx.Dim(cc[3]).IsOffScreen())
+}
+
+func (s *_viewport) Scrolls_nested_by_scroll_feature(t *T) {
+	vp, cc := viewporter()
+	fx := fx(t, vp)
+	t.FatalOn(fx.Lines.Focus(cc[0]))
+	fx.FireKey(PgDn)
+	t.True(fx.Dim(cc[1]).IsOffScreen())
+	_, y, _, h := fx.Dim(cc[2]).Printable()
+	t.True(y == -3 && h == 10)
+	_, y, _, h = fx.Dim(cc[4]).Printable()
+	t.True(y == 17 && h == 8)
+	t.Eq("", strings.TrimSpace(fx.Screen()[0]))
+	t.True(strings.HasPrefix(fx.Screen()[7], "nested 3"))
+	fx.FireKey(PgUp)
+	_, y, _, _ = fx.Dim(cc[0]).Printable()
+	t.Eq(0, y)
+}
+
+func (s *_viewport) Scrolls_nested_by_mouse_wheel(t *T) {
+	vp, cc := viewporter()
+	fx := fx(t, vp)
+	fx.PostClick(5, 5, WheelDown, ZeroModifier)
+	_, y, _, _ := fx.Dim(cc[2]).Printable()
+	t.Eq(-3, y)
+	fx.PostClick(5, 5, WheelUp, ZeroModifier)
+	_, y, _, _ = fx.Dim(cc[0]).Printable()
+	t.Eq(0, y)
+}
+
+func (s *_viewport) Scrolls_focused_nested_into_view(t *T) {
+	vp, cc := viewporter()
+	fx := fx(t, vp)
+	t.FatalOn(fx.Lines.Focus(cc[0]))
+	fx.FireKeys(Tab, Tab, Tab)
+	t.Eq(cc[3], fx.Lines.scr.focus.userComponent())
+	_, y, _, h := fx.Dim(cc[3]).Printable()
+	t.True(y == 15 && h == 10)
+	fx.FireKey(Backtab)
+	fx.FireKey(Backtab)
+	fx.FireKey(Backtab)
+	t.Eq(cc[0], fx.Lines.scr.focus.userComponent())
+	_, y, _, _ = fx.Dim(cc[0]).Printable()
+	t.Eq(0, y)
+}
+
+func (s *_viewport) Shows_canvas_position_in_scroll_bar(t *T) {
+	vp, cc := viewporter()
+	vp.onInit = func(c *cmpFX, e *Env) {
+		c.FF.Set(Scrollable)
+		c.Scroll.Bar = true
+	}
+	fx := fx(t, vp)
+	_, _, w, _ := fx.Dim(cc[0]).Printable()
+	t.Eq(79, w)
+	t.Eq(0, vp.layoutComponent().wrapped().Scroll.BarPosition())
+	fx.FireKey(PgDn)
+	t.Eq(17, vp.layoutComponent().wrapped().Scroll.BarPosition())
+}
+
+func (s *_viewport) Shows_all_lines_of_a_nested_higher_than_itself(
+	t *T,
+) {
+	vp, c := &viewportingFX{}, &cmpFX{}
+	vp.onInit = func(c *cmpFX, e *Env) {
+		c.FF.Set(Scrollable)
+		Print(c.Gaps(0).Filling(), '•')
+	}
+	c.onInit = func(c *cmpFX, e *Env) {
+		c.Dim().SetHeight(40)
+		for i := 0; i < 40; i++ {
+			fmt.Fprintf(e.LL(i), "line %d", i)
+		}
+	}
+	vp.CC = append(vp.CC, c)
+	fx := fx(t, vp)
+	t.FatalOn(fx.Lines.Update(vp, nil, func(e *Env) {
+		vp.Scroll.ToBottom()
+	}))
+	scr := fx.Screen()
+	t.True(strings.HasPrefix(scr[0], " •••"))
+	t.True(strings.HasPrefix(scr[1], "•line 17"))
+	t.True(strings.HasPrefix(scr[23], "•line 39"))
+	_, y, _, h := fx.Dim(c).Printable()
+	t.True(y == -16 && h == 40)
+}
+
+func TestViewport(t *testing.T) {
+	t.Parallel()
+	Run(&_viewport{}, t)
+}
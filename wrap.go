@@ -0,0 +1,339 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package lines
+
+// A wrapping component, i.e. a component whose lines are maintained in
+// WordWrapping or RuneWrapping mode, displays a content line which is
+// wider than the component's content area over several screen lines.
+// Such a screen line is called a row of its content line whereas a
+// content line which fits into the component's width has exactly one
+// row.  Scrolling, line focus and cell focus of a wrapping component
+// operate on rows.  Rows are calculated from the component's current
+// width, i.e. a resize re-wraps a wrapping component's lines.
+
+// isWrapping returns true if given component c's content lines are
+// wrapped.  Note the content of a component source isn't wrapped.
+func (c *component) isWrapping() bool {
+	return c.Src == nil && c.mod&(WordWrapping|RuneWrapping) != 0
+}
+
+// wrap returns the spans of given displayed runes rr which are
+// displayed in rows of given width.  Is words set a row is broken after
+// its last space if there is one; otherwise rows are broken at given
+// width.  A space at which a row is broken word-wise is part of the
+// row's span but not displayed.  A wide cluster is never broken apart.
+// Note runes which fit into given width as well as a width less than
+// one result in exactly one span.
+func wrap(rr []rune, width int, words bool) [][2]int {
+	if width <= 0 || len(rr) <= width {
+		return [][2]int{{0, len(rr)}}
+	}
+	ss := [][2]int{}
+	for start := 0; start < len(rr); {
+		end := start + width
+		if end >= len(rr) {
+			ss = append(ss, [2]int{start, len(rr)})
+			break
+		}
+		if rr[end] == wideCell && end-1 > start {
+			end--
+		}
+		if words {
+			if rr[end] == ' ' {
+				end++
+			} else if i := lastSpace(rr[start:end]); i > 0 {
+				end = start + i + 1
+			}
+		}
+		ss = append(ss, [2]int{start, end})
+		start = end
+	}
+	return ss
+}
+
+// lastSpace returns the index of the last space in given runes rr or
+// -1 if there is none.
+func lastSpace(rr []rune) int {
+	for i := len(rr) - 1; i >= 0; i-- {
+		if rr[i] == ' ' {
+			return i
+		}
+	}
+	return -1
+}
+
+// rowSpans holds the row spans of a line for the width, the wrapping
+// mode and the tab width they were calculated for.
+type rowSpans struct {
+	width, tabWidth int
+	words           bool
+	spans           [][2]int
+}
+
+// rows returns the spans of given line l's rows for given width whereas
+// leading tabs and clusters are expanded, see wrap.  The spans are
+// cached until l becomes dirty or they are requested for a different
+// width, wrapping mode or tab width.
+func (l *Line) rows(width int, words bool, gg *Globals) [][2]int {
+	if w := l.wrapped; w != nil && w.width == width &&
+		w.words == words && w.tabWidth == gg.tabWidth {
+		return w.spans
+	}
+	rr, _, _ := l.expandLeadingTabs(
+		append([]rune{}, l.rr...), nil, gg.tabWidth)
+	rr, _, _, _ = expandClusters(rr, nil)
+	l.wrapped = &rowSpans{width: width, tabWidth: gg.tabWidth,
+		words: words, spans: wrap(rr, width, words)}
+	return l.wrapped.spans
+}
+
+// syncRows writes given line l's rows starting with the row with given
+// index from at coordinates x and y to the screen with given rune
+// writer rw.  At most height rows are written and their number is
+// returned.  A line having only one row is written like a not wrapped
+// line, i.e. its fillers are expanded.
+func (l *Line) syncRows(
+	x, y, width, from, height int, words bool, rw runeWriter,
+	gg *Globals,
+) int {
+	if height <= 0 {
+		return 0
+	}
+	ss := l.ss.copyWithDefault(gg.Style(Default))
+	rr, ss, _ := l.expandLeadingTabs(append([]rune{}, l.rr...), ss,
+		gg.tabWidth)
+	rr, ss, _, cc := expandClusters(rr, ss)
+	spans := l.rows(width, words, gg)
+	if len(spans) == 1 {
+		if from == 0 {
+			l.sync(x, y, width, rw, gg)
+		}
+		return 1 - from
+	}
+	l.setClean()
+	if l.ff&(Highlighted|TrimmedHighlighted) != 0 {
+		ss = l.highlighted(rr, ss, gg)
+	}
+	n := 0
+	for _, s := range spans[from:] {
+		if n == height {
+			break
+		}
+		for i := 0; i < width; i++ {
+			if s[0]+i < s[1] {
+				if r := rr[s[0]+i]; r != wideCell {
+					displayCell(rw, x+i, y+n, r, cc, ss.of(s[0]+i))
+				}
+				continue
+			}
+			rw.Display(x+i, y+n, ' ', ss.of(len(rr)))
+		}
+		n++
+	}
+	return n
+}
+
+// wrapWords returns true if a wrapping component c breaks its lines
+// word-wise.
+func (c *component) wrapWords() bool { return c.mod&WordWrapping != 0 }
+
+// rowsOf returns the spans of the rows of the content line with given
+// index idx of given wrapping component c.  A not existing line has
+// one empty row.
+func (c *component) rowsOf(idx int) [][2]int {
+	if idx < 0 || idx >= len(*c.ll) {
+		return [][2]int{{0, 0}}
+	}
+	_, _, width, _ := c.ContentArea()
+	return (*c.ll)[idx].rows(width, c.wrapWords(), c.gg)
+}
+
+// firstRow returns the row of given component c's first displayed line
+// which is displayed first.
+func (c *component) firstRow() int {
+	if c._firstRow == 0 {
+		return 0
+	}
+	if n := len(c.rowsOf(c._first)); c._firstRow >= n {
+		return n - 1
+	}
+	return c._firstRow
+}
+
+// rowOf returns the index of the first row of the content line with
+// given index idx of given component c.  Is c not wrapping this is idx.
+func (c *component) rowOf(idx int) int {
+	if !c.isWrapping() {
+		return idx
+	}
+	r := 0
+	for i := 0; i < idx; i++ {
+		r += len(c.rowsOf(i))
+	}
+	return r
+}
+
+// row returns the index of the row which is displayed first by given
+// component c.  Is c not wrapping this is its first displayed line.
+func (c *component) row() int {
+	if !c.isWrapping() {
+		return c.First()
+	}
+	return c.rowOf(c._first) + c.firstRow()
+}
+
+// rowsLen returns the number of rows of given component c's content
+// lines.  Is c not wrapping this is its number of content lines.
+func (c *component) rowsLen() int {
+	if !c.isWrapping() {
+		return c.Len()
+	}
+	return c.rowOf(len(*c.ll))
+}
+
+// lineAt returns the index of the content line of given wrapping
+// component c which has the row with given index r together with the
+// index of r within the line's rows.  Rows after the last content line
+// are accounted as not existing lines having one row each.
+func (c *component) lineAt(r int) (line, row int) {
+	for i := 0; i < len(*c.ll); i++ {
+		n := len(c.rowsOf(i))
+		if r < n {
+			return i, r
+		}
+		r -= n
+	}
+	return len(*c.ll) + r, 0
+}
+
+// setRow sets given component c's first displayed row to given row r
+// and flags c dirty if it changes.  Is c not wrapping r is set as its
+// first displayed line, see setFirst.
+func (c *component) setRow(r int) {
+	if !c.isWrapping() {
+		c.setFirst(r)
+		return
+	}
+	if r < 0 || r >= c.rowsLen() {
+		return
+	}
+	line, row := c.lineAt(r)
+	if line == c._first && row == c.firstRow() {
+		return
+	}
+	c._first, c._firstRow = line, row
+	if c.dirty {
+		return
+	}
+	c.dirty = true
+}
+
+// syncWrapped writes the rows of given wrapping component c starting
+// with its first displayed row into given content area.
+func (c *component) syncWrapped(x, y, width, height int, rw runeWriter) {
+	from, n := c.firstRow(), 0
+	c.ll.For(c._first, func(_ int, l *Line) (stop bool) {
+		n += l.syncRows(x, y+n, width, from, height-n, c.wrapWords(),
+			rw, c.gg)
+		from = 0
+		return n >= height
+	})
+}
+
+// scrollToRows scrolls given wrapping component c that all rows of the
+// content line with given index idx are displayed if possible;
+// otherwise its first row is displayed first.
+func (s Scroller) scrollToRows(idx int) {
+	height := s.c.ContentScreenLines()
+	if height <= 0 || idx < 0 || idx >= s.c.Len() {
+		return
+	}
+	first := s.c.rowOf(idx)
+	last := first + len(s.c.rowsOf(idx)) - 1
+	s.scrollToRow(last)
+	s.scrollToRow(first)
+}
+
+// scrollToRow scrolls given wrapping component c minimally that the
+// row with given index r is displayed.
+func (s Scroller) scrollToRow(r int) {
+	height, row := s.c.ContentScreenLines(), s.c.row()
+	switch {
+	case r < row:
+		s.c.setRow(r)
+	case r >= row+height:
+		s.c.setRow(r - height + 1)
+	}
+}
+
+// leadingTabs returns the number of given line l's leading tabs.
+func (l *Line) leadingTabs() int {
+	for i, r := range l.rr {
+		if r != '\t' {
+			return i
+		}
+	}
+	return len(l.rr)
+}
+
+// spanCell maps given index idx into the row spans of given line l,
+// i.e. into its runes with expanded leading tabs and clusters, to the
+// cell of l which is displayed at idx.  Note a leading tab is one cell
+// of l but is displayed in given tab width tw many cells.
+func (l *Line) spanCell(idx, tw int) int {
+	if tc := l.leadingTabs(); idx >= tc*tw {
+		return idx - tc*(tw-1)
+	}
+	return idx / tw
+}
+
+// cellSpan maps given cell of given line l to the index into l's row
+// spans at which the cell is displayed, see spanCell.
+func (l *Line) cellSpan(cell, tw int) int {
+	if tc := l.leadingTabs(); cell >= tc {
+		return cell + tc*(tw-1)
+	}
+	return cell * tw
+}
+
+// wrappedCell returns the cell of the focused line of given line focus
+// s's wrapping component at the cursor position with given screen line
+// index slIdx and cell index scIdx together with the focused line's row
+// spans.
+func (s *LineFocus) wrappedCell(slIdx, scIdx int) (int, [][2]int) {
+	rr := s.c.rowsOf(s.current)
+	r := s.c.row() + slIdx - s.c.rowOf(s.current)
+	if r < 0 {
+		r = 0
+	}
+	if r >= len(rr) {
+		r = len(rr) - 1
+	}
+	return s.Line().spanCell(rr[r][0]+scIdx, s.c.gg.tabWidth), rr
+}
+
+// setWrappedCell sets the cursor of given line focus s's wrapping
+// component onto given cell of the focused line having given row spans
+// rr.  The cursor's row is scrolled into view if necessary.  Returned
+// are the screen line and cell index of the set cursor.
+func (s *LineFocus) setWrappedCell(cell int, rr [][2]int) (int, int) {
+	idx := s.Line().cellSpan(cell, s.c.gg.tabWidth)
+	r := len(rr) - 1
+	for i, span := range rr {
+		if idx < span[1] {
+			r = i
+			break
+		}
+	}
+	_, _, width, _ := s.c.ContentArea()
+	column := idx - rr[r][0]
+	if column >= width {
+		column = width - 1
+	}
+	row := s.c.rowOf(s.current) + r
+	s.c.Scroll.scrollToRow(row)
+	s.c.setCursor(row-s.c.row(), column)
+	return row - s.c.row(), column
+}
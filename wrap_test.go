@@ -0,0 +1,192 @@
+// Copyright (c) 2022 Stephan Lukits. All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package lines
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/slukits/gounit"
+)
+
+type wrapping struct{ Suite }
+
+func (s *wrapping) SetUp(t *T) { t.Parallel() }
+
+// wrapFX returns a fixture of given width and height whose component
+// displays given content in given wrapping mode having given features.
+func (s *wrapping) wrapFX(
+	t *T, width, height int, mode ComponentMode, content string,
+	ff ...FeatureMask,
+) (*Fixture, *cmpFX) {
+	cmp := &cmpFX{onInit: func(c *cmpFX, e *Env) {
+		c.LL.Mod(mode)
+		for _, f := range ff {
+			c.FF.Set(f)
+		}
+		fmt.Fprint(e, content)
+	}}
+	fx := fx(t, cmp)
+	fx.FireResize(width, height)
+	return fx, cmp
+}
+
+func (s *wrapping) Breaks_lines_after_their_last_fitting_space(t *T) {
+	fx, _ := s.wrapFX(t, 8, 3, WordWrapping, "aaa bbb ccc dd")
+	t.Eq("aaa bbb \nccc dd  \n        ", fx.Screen())
+}
+
+func (s *wrapping) Breaks_lines_at_the_width_if_rune_wrapping(t *T) {
+	fx, _ := s.wrapFX(t, 5, 3, RuneWrapping, "0123456789ab")
+	t.Eq("01234\n56789\nab   ", fx.Screen())
+}
+
+func (s *wrapping) Breaks_words_not_fitting_into_a_row(t *T) {
+	fx, _ := s.wrapFX(t, 4, 2, WordWrapping, "abcdef")
+	t.Eq("abcd\nef  ", fx.Screen())
+}
+
+func (s *wrapping) Re_wraps_lines_on_resize(t *T) {
+	fx, _ := s.wrapFX(t, 5, 2, RuneWrapping, "0123456789")
+	t.Eq("01234\n56789", fx.Screen())
+	fx.FireResize(10, 2)
+	t.Eq("0123456789\n          ", fx.Screen())
+}
+
+func (s *wrapping) Truncates_lines_after_wrapping_is_switched_off(t *T) {
+	fx, cmp := s.wrapFX(t, 5, 2, RuneWrapping, "0123456789")
+	t.FatalOn(fx.Lines.Update(cmp, nil, func(e *Env) {
+		cmp.LL.Mod(Truncating)
+	}))
+	t.Eq("01234\n     ", fx.Screen())
+}
+
+func (s *wrapping) Scrolls_by_rows(t *T) {
+	fx, cmp := s.wrapFX(t, 4, 2, RuneWrapping, "aaaabbbb\nccccdddd")
+	t.FatalOn(fx.Lines.Update(cmp, nil, func(e *Env) {
+		t.Not.True(cmp.Scroll.IsAtBottom())
+		cmp.Scroll.Down()
+	}))
+	t.Eq("bbbb\ncccc", fx.Screen())
+	t.FatalOn(fx.Lines.Update(cmp, nil, func(e *Env) {
+		cmp.Scroll.ToBottom()
+	}))
+	t.Eq("cccc\ndddd", fx.Screen())
+	t.FatalOn(fx.Lines.Update(cmp, nil, func(e *Env) {
+		t.True(cmp.Scroll.IsAtBottom())
+		cmp.Scroll.Up()
+	}))
+	t.Eq("bbbb\ncccc", fx.Screen())
+}
+
+func (s *wrapping) Scrolls_all_rows_of_a_line_into_view(t *T) {
+	fx, cmp := s.wrapFX(t, 4, 2, RuneWrapping, "aaaabbbb\nccccdddd")
+	t.FatalOn(fx.Lines.Update(cmp, nil, func(e *Env) {
+		cmp.Scroll.To(1)
+	}))
+	t.Eq("cccc\ndddd", fx.Screen())
+}
+
+func (s *wrapping) Focuses_lines_spanning_several_rows(t *T) {
+	fx, cmp := s.wrapFX(t, 4, 3, RuneWrapping, "aaaabbbb\ncc\ndddd",
+		LinesFocusable)
+	fx.FireKey(Down)
+	fx.FireKey(Down)
+	t.FatalOn(fx.Lines.Update(cmp, nil, func(e *Env) {
+		t.Eq(1, cmp.LL.Focus.Current())
+		t.Eq(2, cmp.LL.Focus.Screen())
+	}))
+	fx.FireKey(Down)
+	t.Eq("bbbb\ncc  \ndddd", fx.Screen())
+	t.FatalOn(fx.Lines.Update(cmp, nil, func(e *Env) {
+		t.Eq(2, cmp.LL.Focus.Current())
+		t.Eq(2, cmp.LL.Focus.Screen())
+	}))
+}
+
+func (s *wrapping) Highlights_all_rows_of_a_focused_line(t *T) {
+	fx, _ := s.wrapFX(t, 4, 3, RuneWrapping, "aaaabb\ncc",
+		LinesFocusable|HighlightEnabled)
+	fx.FireKey(Down)
+	t.True(fx.Cells()[0].HasAA(0, Reverse))
+	t.True(fx.Cells()[1].HasAA(3, Reverse))
+	t.Not.True(fx.Cells()[2].HasAA(0, Reverse))
+}
+
+func (s *wrapping) Moves_cursor_across_rows(t *T) {
+	fx, cmp := s.wrapFX(t, 4, 2, RuneWrapping, "aaaabb",
+		CellFocusable)
+	fx.FireKey(Down)
+	for i := 0; i < 4; i++ {
+		fx.FireKey(Right)
+	}
+	t.FatalOn(fx.Lines.Update(cmp, nil, func(e *Env) {
+		ln, cl, _ := cmp.CursorPosition()
+		t.True(ln == 1 && cl == 0)
+	}))
+	fx.FireKey(Left)
+	t.FatalOn(fx.Lines.Update(cmp, nil, func(e *Env) {
+		ln, cl, _ := cmp.CursorPosition()
+		t.True(ln == 0 && cl == 3)
+		_, _, moved := cmp.LL.Focus.LastCell()
+		t.True(moved)
+		ln, cl, _ = cmp.CursorPosition()
+		t.True(ln == 1 && cl == 1)
+		t.True(cmp.LL.Focus.Eol())
+		_, _, moved = cmp.LL.Focus.FirstCell()
+		t.True(moved)
+		ln, cl, _ = cmp.CursorPosition()
+		t.True(ln == 0 && cl == 0)
+	}))
+}
+
+func (s *wrapping) Moves_cursor_across_rows_after_leading_tabs(t *T) {
+	fx, cmp := s.wrapFX(t, 4, 3, RuneWrapping, "\tabcdef",
+		CellFocusable)
+	t.Eq("    \nabcd\nef  ", fx.Screen())
+	fx.FireKey(Down)
+	fx.FireKey(Right)
+	t.FatalOn(fx.Lines.Update(cmp, nil, func(e *Env) {
+		ln, cl, _ := cmp.CursorPosition()
+		t.True(ln == 1 && cl == 0)
+		t.Not.True(cmp.LL.Focus.Eol())
+		_, _, moved := cmp.LL.Focus.LastCell()
+		t.True(moved)
+		ln, cl, _ = cmp.CursorPosition()
+		t.True(ln == 2 && cl == 1)
+		t.True(cmp.LL.Focus.Eol())
+		_, _, moved = cmp.LL.Focus.PreviousCell()
+		t.True(moved)
+		ln, cl, _ = cmp.CursorPosition()
+		t.True(ln == 2 && cl == 0)
+		cmp.LL.Focus.FirstCell()
+		ln, cl, _ = cmp.CursorPosition()
+		t.True(ln == 0 && cl == 0)
+	}))
+}
+
+func (s *wrapping) Caches_rows_until_a_line_or_its_width_changes(t *T) {
+	l, gg := &Line{}, newGlobals(nil)
+	l.set("aaaabb")
+	rr := l.rows(4, false, gg)
+	t.True(&rr[0] == &l.rows(4, false, gg)[0])
+	t.Eq([][2]int{{0, 3}, {3, 6}}, l.rows(3, false, gg))
+	l.set("aaaabbbbc")
+	t.Eq([][2]int{{0, 3}, {3, 6}, {6, 9}}, l.rows(3, false, gg))
+}
+
+func (s *wrapping) Maps_coordinates_to_wrapped_lines(t *T) {
+	fx, cmp := s.wrapFX(t, 4, 3, RuneWrapping, "aaaabbbb\ncc")
+	t.FatalOn(fx.Lines.Update(cmp, nil, func(e *Env) {
+		t.Eq(0, cmp.Scroll.CoordinateToContentIndex(0))
+		t.Eq(0, cmp.Scroll.CoordinateToContentIndex(1))
+		t.Eq(1, cmp.Scroll.CoordinateToContentIndex(2))
+	}))
+}
+
+func TestWrapping(t *testing.T) {
+	t.Parallel()
+	Run(&wrapping{}, t)
+}